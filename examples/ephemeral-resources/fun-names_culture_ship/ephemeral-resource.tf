@@ -0,0 +1,22 @@
+# The following example shows how to feed a ship name straight into a
+# write-only argument, so that it is never stored in the plan or state.
+# Write-only arguments require Terraform 1.11 or later.
+
+ephemeral "fun-names_culture_ship" "passphrase" {
+  separator = "-"
+}
+
+resource "aws_secretsmanager_secret" "passphrase" {
+  name = "passphrase"
+}
+
+resource "aws_secretsmanager_secret_version" "passphrase" {
+  secret_id = aws_secretsmanager_secret.passphrase.id
+
+  # Write-only, so Terraform sends the name to AWS but never records it.
+  secret_string_wo = ephemeral.fun-names_culture_ship.passphrase.id
+
+  # Bump the version to store a new name, as write-only arguments are not
+  # compared between runs.
+  secret_string_wo_version = 1
+}
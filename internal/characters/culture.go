@@ -0,0 +1,48 @@
+/*
+  Characters: Generate character names. Based on the petname library by Dustin Kirkland
+*/
+
+package characters
+
+import (
+	"math/rand"
+)
+
+var (
+	cultureCharacters = [...]string{
+		"Bora Horza Gobuchul",
+		"Byr Genar-Hofoen",
+		"Cheradenine Zakalwe",
+		"Dajeil Gelian",
+		"Diziet Sma",
+		"Djan Seriy Anaplian",
+		"Fal 'Ngeestra",
+		"Jernau Morat Gurgeh",
+		"Kabo Ischloear",
+		"Lededje Y'breq",
+		"Mahrai Ziller",
+		"Perosteck Balveda",
+		"Ulver Seich",
+		"Vyr Cossont",
+		"Yime Nsokyi",
+	}
+)
+
+// NonDeterministicMode is a no-op, kept for compatibility.
+//
+// Deprecated: Generate draws from the global math/rand source, which is
+// seeded at random when the program starts, so there is no need to seed it.
+func NonDeterministicMode() {}
+
+// Generate returns the full name of a character, with its parts separated by
+// spaces. Every name has at least a first and a last part.
+func Generate() string {
+	return cultureCharacters[rand.Intn(len(cultureCharacters))]
+}
+
+// GenerateWithRand is like Generate, but draws the character from the given
+// source of randomness rather than the global one, so that a seeded source
+// always yields the same character.
+func GenerateWithRand(rnd *rand.Rand) string {
+	return cultureCharacters[rnd.Intn(len(cultureCharacters))]
+}
@@ -0,0 +1,55 @@
+/*
+  Drones: Generate drone names. Based on the petname library by Dustin Kirkland
+*/
+
+package drones
+
+import (
+	"math/rand"
+	"strings"
+)
+
+var (
+	cultureDrones = [...]string{
+		"Chamlis Amalk-Ney",
+		"E. H. Tersono",
+		"Flere-Imsaho",
+		"Jase",
+		"Mawhrin-Skel",
+		"Pyan",
+		"Skaffen-Amtiskaw",
+		"Turminder Xuss",
+		"Unaha-Closp",
+	}
+)
+
+// NonDeterministicMode is a no-op, kept for compatibility.
+//
+// Deprecated: Generate draws from the global math/rand source, which is
+// seeded at random when the program starts, so there is no need to seed it.
+func NonDeterministicMode() {}
+
+func CultureDrone() string {
+	return cultureDrones[rand.Intn(len(cultureDrones))]
+}
+
+func Generate(separator string) string {
+	return join(CultureDrone(), separator)
+}
+
+// GenerateWithRand is like Generate, but draws the drone from the given source
+// of randomness rather than the global one, so that a seeded source always
+// yields the same drone.
+func GenerateWithRand(separator string, rnd *rand.Rand) string {
+	return join(cultureDrones[rnd.Intn(len(cultureDrones))], separator)
+}
+
+func join(cultureDrone, separator string) string {
+	// Split the drone name into its words, including both halves of
+	// hyphenated names
+	words := strings.FieldsFunc(cultureDrone, func(r rune) bool {
+		return r == ' ' || r == '-'
+	})
+	// Join the words with the separator
+	return strings.Join(words, separator)
+}
@@ -0,0 +1,61 @@
+/*
+  Minds: Generate Mind names. Based on the petname library by Dustin Kirkland
+*/
+
+package minds
+
+import (
+	"math/rand"
+	"strings"
+)
+
+var (
+	// cultureMinds holds the Minds of the Orbital hubs, along with the ship
+	// Minds of the Interesting Times Gang from Excession.
+	cultureMinds = [...]string{
+		"Anticipation Of A New Lover's Arrival, The",
+		"Appeal To Reason",
+		"Chiark Hub",
+		"Different Tan",
+		"Ethics Gradient",
+		"Fate Amenable To Change",
+		"Limivorous",
+		"Masaq' Hub",
+		"Not Invented Here",
+		"Serious Callers Only",
+		"Shoot Them Later",
+		"Sober Counsel",
+		"Steely Glint",
+		"Vavatch Hub",
+		"Wisdom Like Silence",
+		"Yawning Angel",
+	}
+)
+
+// NonDeterministicMode is a no-op, kept for compatibility.
+//
+// Deprecated: Generate draws from the global math/rand source, which is
+// seeded at random when the program starts, so there is no need to seed it.
+func NonDeterministicMode() {}
+
+func CultureMind() string {
+	return cultureMinds[rand.Intn(len(cultureMinds))]
+}
+
+func Generate(separator string) string {
+	return join(CultureMind(), separator)
+}
+
+// GenerateWithRand is like Generate, but draws the Mind from the given source
+// of randomness rather than the global one, so that a seeded source always
+// yields the same Mind.
+func GenerateWithRand(separator string, rnd *rand.Rand) string {
+	return join(cultureMinds[rnd.Intn(len(cultureMinds))], separator)
+}
+
+func join(cultureMind, separator string) string {
+	// Split the Mind name on any run of whitespace
+	words := strings.Fields(cultureMind)
+	// Join the words with the separator
+	return strings.Join(words, separator)
+}
@@ -0,0 +1,46 @@
+/*
+  Orbitals: Generate orbital names. Based on the petname library by Dustin Kirkland
+*/
+
+package orbitals
+
+import (
+	"math/rand"
+	"strings"
+)
+
+var (
+	cultureOrbitals = [...]string{
+		"Chiark Orbital",
+		"Masaq' Orbital",
+		"Vavatch Orbital",
+	}
+)
+
+// NonDeterministicMode is a no-op, kept for compatibility.
+//
+// Deprecated: Generate draws from the global math/rand source, which is
+// seeded at random when the program starts, so there is no need to seed it.
+func NonDeterministicMode() {}
+
+func CultureOrbital() string {
+	return cultureOrbitals[rand.Intn(len(cultureOrbitals))]
+}
+
+func Generate(separator string) string {
+	return join(CultureOrbital(), separator)
+}
+
+// GenerateWithRand is like Generate, but draws the orbital from the given source
+// of randomness rather than the global one, so that a seeded source always
+// yields the same orbital.
+func GenerateWithRand(separator string, rnd *rand.Rand) string {
+	return join(cultureOrbitals[rnd.Intn(len(cultureOrbitals))], separator)
+}
+
+func join(cultureOrbital, separator string) string {
+	// Split the orbital name on any run of whitespace
+	words := strings.Fields(cultureOrbital)
+	// Join the words with the separator
+	return strings.Join(words, separator)
+}
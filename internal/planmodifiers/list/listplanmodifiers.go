@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package listplanmodifiers
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// RequiresReplaceIfValuesNotNull is the list counterpart of
+// mapplanmodifiers.RequiresReplaceIfValuesNotNull: any change to the list
+// triggers replacement, unless the list was null in the prior state and every
+// configured element is null.
+func RequiresReplaceIfValuesNotNull() planmodifier.List {
+	return requiresReplaceIfValuesNotNullModifier{}
+}
+
+type requiresReplaceIfValuesNotNullModifier struct{}
+
+func (r requiresReplaceIfValuesNotNullModifier) PlanModifyList(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	if req.State.Raw.IsNull() {
+		// if we're creating the resource, no need to delete and
+		// recreate it
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		// if we're deleting the resource, no need to delete and
+		// recreate it
+		return
+	}
+
+	// If there are no differences, do not mark the resource for replacement
+	// and ensure the plan matches the configuration.
+	if req.ConfigValue.Equal(req.StateValue) {
+		return
+	}
+
+	if req.StateValue.IsNull() {
+		// Adding a list of only null elements where there was none before
+		// does not change anything worth recreating the resource for.
+		allNullValues := true
+
+		for _, configValue := range req.ConfigValue.Elements() {
+			if !configValue.IsNull() {
+				allNullValues = false
+			}
+		}
+
+		if allNullValues {
+			return
+		}
+	}
+
+	// Unlike map keys, list elements are identified by their position, so
+	// adding, removing, reordering or changing any element moves the values
+	// the resource was keyed on.
+	resp.RequiresReplace = true
+}
+
+// Description returns a human-readable description of the plan modifier.
+func (r requiresReplaceIfValuesNotNullModifier) Description(ctx context.Context) string {
+	return "If the value of this attribute changes, Terraform will destroy and recreate the resource."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (r requiresReplaceIfValuesNotNullModifier) MarkdownDescription(ctx context.Context) string {
+	return "If the value of this attribute changes, Terraform will destroy and recreate the resource."
+}
@@ -6,13 +6,24 @@ package mapplanmodifiers
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 func RequiresReplaceIfValuesNotNull() planmodifier.Map {
 	return requiresReplaceIfValuesNotNullModifier{}
 }
 
+// DynamicRequiresReplaceIfValuesNotNull is like RequiresReplaceIfValuesNotNull,
+// for dynamic attributes holding a map or an object, so that keepers need not
+// be strings. Values are compared along with their types, so changing a value
+// from 1 to "1" also triggers replacement. Any other dynamic value triggers
+// replacement whenever it changes.
+func DynamicRequiresReplaceIfValuesNotNull() planmodifier.Dynamic {
+	return requiresReplaceIfValuesNotNullModifier{}
+}
+
 type requiresReplaceIfValuesNotNullModifier struct{}
 
 func (r requiresReplaceIfValuesNotNullModifier) PlanModifyMap(ctx context.Context, req planmodifier.MapRequest, resp *planmodifier.MapResponse) {
@@ -34,68 +45,117 @@ func (r requiresReplaceIfValuesNotNullModifier) PlanModifyMap(ctx context.Contex
 		return
 	}
 
-	if req.StateValue.IsNull() {
+	resp.RequiresReplace = requiresReplace(req.StateValue.IsNull(), req.StateValue.Elements(), req.ConfigValue.Elements())
+}
+
+func (r requiresReplaceIfValuesNotNullModifier) PlanModifyDynamic(ctx context.Context, req planmodifier.DynamicRequest, resp *planmodifier.DynamicResponse) {
+	if req.State.Raw.IsNull() {
+		// if we're creating the resource, no need to delete and
+		// recreate it
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		// if we're deleting the resource, no need to delete and
+		// recreate it
+		return
+	}
+
+	// If there are no differences, do not mark the resource for replacement
+	// and ensure the plan matches the configuration.
+	if req.ConfigValue.Equal(req.StateValue) {
+		return
+	}
+
+	stateElements, stateNull, ok := dynamicElements(req.StateValue)
+	if !ok {
+		resp.RequiresReplace = true
+		return
+	}
+
+	configElements, _, ok := dynamicElements(req.ConfigValue)
+	if !ok {
+		resp.RequiresReplace = true
+		return
+	}
+
+	resp.RequiresReplace = requiresReplace(stateNull, stateElements, configElements)
+}
+
+// dynamicElements returns the elements of the map or object held by a
+// dynamic value, and whether it is null. It reports false if the value holds
+// anything else.
+func dynamicElements(value basetypes.DynamicValue) (map[string]attr.Value, bool, bool) {
+	if value.IsNull() || value.IsUnderlyingValueNull() {
+		return nil, true, true
+	}
+
+	switch v := value.UnderlyingValue().(type) {
+	case basetypes.MapValue:
+		return v.Elements(), false, true
+	case basetypes.ObjectValue:
+		return v.Attributes(), false, true
+	default:
+		return nil, false, false
+	}
+}
+
+// requiresReplace reports whether a change from the state elements to the
+// config elements should replace the resource, given that the two differ.
+// A null value is treated the same as a missing key, so replacement is
+// triggered when:
+//
+//   - a key is added with a value that is not null,
+//   - the value of a key changes, including from or to null, or
+//   - a key whose value was not null is removed.
+func requiresReplace(stateNull bool, stateElements, configElements map[string]attr.Value) bool {
+	if stateNull {
 		// terraform-plugin-sdk would store maps as null if all keys had null
 		// values. To prevent unintentional replacement plans when migrating
 		// to terraform-plugin-framework, only trigger replacement when the
 		// prior state (map) is null and when there are not null map values.
 		allNullValues := true
 
-		for _, configValue := range req.ConfigValue.Elements() {
+		for _, configValue := range configElements {
 			if !configValue.IsNull() {
 				allNullValues = false
 			}
 		}
 
-		if allNullValues {
-			return
-		}
-	} else {
-		// terraform-plugin-sdk would completely omit storing map keys with
-		// null values, so this also must prevent unintentional replacement
-		// in that case as well.
-		allNewNullValues := true
-
-		configMap := req.ConfigValue
-
-		stateMap := req.StateValue
-
-		for configKey, configValue := range configMap.Elements() {
-			stateValue, ok := stateMap.Elements()[configKey]
-
-			// If the key doesn't exist in state and the config value is
-			// null, do not trigger replacement.
-			if !ok && configValue.IsNull() {
-				continue
-			}
+		return !allNullValues
+	}
 
-			// If the state value exists, and it is equal to the config value,
-			// do not trigger replacement.
-			if configValue.Equal(stateValue) {
-				continue
-			}
+	// terraform-plugin-sdk would completely omit storing map keys with
+	// null values, so this also must prevent unintentional replacement
+	// in that case as well.
+	for configKey, configValue := range configElements {
+		stateValue, ok := stateElements[configKey]
 
-			allNewNullValues = false
-			break
+		// If the key doesn't exist in state and the config value is
+		// null, do not trigger replacement.
+		if !ok && configValue.IsNull() {
+			continue
 		}
 
-		for stateKey := range stateMap.Elements() {
-			_, ok := configMap.Elements()[stateKey]
-
-			// If the key doesn't exist in the config, but there is a state
-			// value, trigger replacement.
-			if !ok {
-				allNewNullValues = false
-				break
-			}
+		// If the state value exists, and it is equal to the config value,
+		// do not trigger replacement.
+		if configValue.Equal(stateValue) {
+			continue
 		}
 
-		if allNewNullValues {
-			return
+		return true
+	}
+
+	for stateKey, stateValue := range stateElements {
+		// If the key doesn't exist in the config, but there is a state
+		// value, trigger replacement. Removing a key whose value was
+		// already null changes nothing.
+		if _, ok := configElements[stateKey]; !ok && !stateValue.IsNull() {
+			return true
 		}
 	}
 
-	resp.RequiresReplace = true
+	return false
 }
 
 // Description returns a human-readable description of the plan modifier.
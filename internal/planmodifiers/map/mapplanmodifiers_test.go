@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapplanmodifiers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRequiresReplaceIfValuesNotNull(t *testing.T) {
+	keepers := func(elements map[string]attr.Value) types.Map {
+		if elements == nil {
+			return types.MapNull(types.StringType)
+		}
+		return types.MapValueMust(types.StringType, elements)
+	}
+
+	a := types.StringValue("a")
+	b := types.StringValue("b")
+	null := types.StringNull()
+
+	tests := map[string]struct {
+		state, config map[string]attr.Value
+		want          bool
+	}{
+		"unchanged":             {map[string]attr.Value{"k": a}, map[string]attr.Value{"k": a}, false},
+		"add":                   {map[string]attr.Value{"k": a}, map[string]attr.Value{"k": a, "l": b}, true},
+		"add null":              {map[string]attr.Value{"k": a}, map[string]attr.Value{"k": a, "l": null}, false},
+		"add to null":           {nil, map[string]attr.Value{"k": a}, true},
+		"add null to null":      {nil, map[string]attr.Value{"k": null}, false},
+		"change":                {map[string]attr.Value{"k": a}, map[string]attr.Value{"k": b}, true},
+		"remove":                {map[string]attr.Value{"k": a, "l": b}, map[string]attr.Value{"k": a}, true},
+		"remove last":           {map[string]attr.Value{"k": a}, map[string]attr.Value{}, true},
+		"remove all":            {map[string]attr.Value{"k": a}, nil, true},
+		"remove null":           {map[string]attr.Value{"k": a, "l": null}, map[string]attr.Value{"k": a}, false},
+		"null to value":         {map[string]attr.Value{"k": null}, map[string]attr.Value{"k": a}, true},
+		"value to null":         {map[string]attr.Value{"k": a}, map[string]attr.Value{"k": null}, true},
+		"empty to null":         {map[string]attr.Value{}, nil, false},
+		"all null from nothing": {map[string]attr.Value{}, map[string]attr.Value{"k": null}, false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Only whether the prior state and plan are null matters here.
+			raw := tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})
+
+			req := planmodifier.MapRequest{
+				State:       tfsdk.State{Raw: raw},
+				Plan:        tfsdk.Plan{Raw: raw},
+				StateValue:  keepers(tt.state),
+				ConfigValue: keepers(tt.config),
+				PlanValue:   keepers(tt.config),
+			}
+			resp := &planmodifier.MapResponse{PlanValue: req.PlanValue}
+
+			RequiresReplaceIfValuesNotNull().PlanModifyMap(context.Background(), req, resp)
+
+			if resp.RequiresReplace != tt.want {
+				t.Errorf("expected RequiresReplace to be %t, got %t", tt.want, resp.RequiresReplace)
+			}
+		})
+	}
+}
@@ -42,3 +42,94 @@ func RequiresReplaceUnlessEmptyStringToNull() stringplanmodifier.RequiresReplace
 		resp.RequiresReplace = false
 	}
 }
+
+// RequiresReplaceIfEffectiveValueChanges returns a planmodifier.String that
+// is like stringplanmodifier.RequiresReplaceIf, but only calls ifFunc when
+// the effective value of an optional and computed attribute changes. A null
+// prior state value is taken to be defaultValue, so that explicitly
+// configuring the default where it was previously applied implicitly does not
+// replace the resource, and removing the attribute from the configuration
+// keeps the prior state value rather than changing it.
+func RequiresReplaceIfEffectiveValueChanges(defaultValue string, ifFunc stringplanmodifier.RequiresReplaceIfFunc, description, markdownDescription string) planmodifier.String {
+	return requiresReplaceIfEffectiveValueChangesModifier{
+		defaultValue:        defaultValue,
+		ifFunc:              ifFunc,
+		description:         description,
+		markdownDescription: markdownDescription,
+	}
+}
+
+type requiresReplaceIfEffectiveValueChangesModifier struct {
+	defaultValue        string
+	ifFunc              stringplanmodifier.RequiresReplaceIfFunc
+	description         string
+	markdownDescription string
+}
+
+func (m requiresReplaceIfEffectiveValueChangesModifier) Description(_ context.Context) string {
+	return m.description
+}
+
+func (m requiresReplaceIfEffectiveValueChangesModifier) MarkdownDescription(_ context.Context) string {
+	return m.markdownDescription
+}
+
+func (m requiresReplaceIfEffectiveValueChangesModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Nothing to replace when the resource is being created or destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// An unset value keeps whatever the resource already has.
+	if req.ConfigValue.IsNull() {
+		return
+	}
+
+	prior := req.StateValue.ValueString()
+	if req.StateValue.IsNull() {
+		prior = m.defaultValue
+	}
+
+	if !req.ConfigValue.IsUnknown() && req.ConfigValue.ValueString() == prior {
+		return
+	}
+
+	ifFuncResp := &stringplanmodifier.RequiresReplaceIfFuncResponse{}
+
+	m.ifFunc(ctx, req, ifFuncResp)
+
+	resp.Diagnostics.Append(ifFuncResp.Diagnostics...)
+	resp.RequiresReplace = ifFuncResp.RequiresReplace
+}
+
+// UseStateForUnknownIncludingNull returns a planmodifier.String that is like
+// stringplanmodifier.UseStateForUnknown, but also copies a null prior state
+// value into the plan. This suits computed attributes that are legitimately
+// null once created, which UseStateForUnknown would otherwise leave unknown
+// on every update.
+func UseStateForUnknownIncludingNull() planmodifier.String {
+	return useStateForUnknownIncludingNullModifier{}
+}
+
+type useStateForUnknownIncludingNullModifier struct{}
+
+func (m useStateForUnknownIncludingNullModifier) Description(_ context.Context) string {
+	return "Once set, the value of this attribute in state, even if null, will not change."
+}
+
+func (m useStateForUnknownIncludingNullModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m useStateForUnknownIncludingNullModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Nothing to keep when the resource is being created.
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	if !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifiers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRequiresReplaceIfEffectiveValueChanges(t *testing.T) {
+	always := func(_ context.Context, _ planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+		resp.RequiresReplace = true
+	}
+
+	tests := map[string]struct {
+		state, config types.String
+		want          bool
+	}{
+		"unchanged":                {types.StringValue("-"), types.StringValue("-"), false},
+		"changed":                  {types.StringValue("-"), types.StringValue("_"), true},
+		"unset":                    {types.StringValue("_"), types.StringNull(), false},
+		"explicit default":         {types.StringNull(), types.StringValue("-"), false},
+		"explicit other":           {types.StringNull(), types.StringValue("_"), true},
+		"unknown":                  {types.StringValue("-"), types.StringUnknown(), true},
+		"empty is not the default": {types.StringValue(""), types.StringValue("-"), true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Only whether the prior state and plan are null matters here.
+			raw := tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})
+
+			req := planmodifier.StringRequest{
+				State:       tfsdk.State{Raw: raw},
+				Plan:        tfsdk.Plan{Raw: raw},
+				StateValue:  tt.state,
+				ConfigValue: tt.config,
+				PlanValue:   tt.config,
+			}
+			resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+
+			RequiresReplaceIfEffectiveValueChanges("-", always, "", "").PlanModifyString(context.Background(), req, resp)
+
+			if resp.RequiresReplace != tt.want {
+				t.Errorf("expected RequiresReplace to be %t, got %t", tt.want, resp.RequiresReplace)
+			}
+		})
+	}
+}
+
+func TestUseStateForUnknownIncludingNull(t *testing.T) {
+	tests := map[string]struct {
+		state, config, plan, want types.String
+	}{
+		"null state":     {types.StringNull(), types.StringNull(), types.StringUnknown(), types.StringNull()},
+		"known state":    {types.StringValue("GSV"), types.StringNull(), types.StringUnknown(), types.StringValue("GSV")},
+		"known plan":     {types.StringNull(), types.StringValue("GCU"), types.StringValue("GCU"), types.StringValue("GCU")},
+		"unknown config": {types.StringNull(), types.StringUnknown(), types.StringUnknown(), types.StringUnknown()},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			raw := tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})
+
+			req := planmodifier.StringRequest{
+				State:       tfsdk.State{Raw: raw},
+				Plan:        tfsdk.Plan{Raw: raw},
+				StateValue:  tt.state,
+				ConfigValue: tt.config,
+				PlanValue:   tt.plan,
+			}
+			resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+
+			UseStateForUnknownIncludingNull().PlanModifyString(context.Background(), req, resp)
+
+			if !resp.PlanValue.Equal(tt.want) {
+				t.Errorf("expected plan value %s, got %s", tt.want, resp.PlanValue)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/matthewbaggett/terraform-provider-fun-names/internal/spaceships"
+)
+
+var _ datasource.DataSource = (*dataSourceCultureShip)(nil)
+
+func NewCultureShipDataSource() datasource.DataSource {
+	return &dataSourceCultureShip{}
+}
+
+type dataSourceCultureShip struct{}
+
+func (d *dataSourceCultureShip) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_culture_ship"
+}
+
+func (d *dataSourceCultureShip) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The data source `culture_ship` returns a name of a ship from the Culture Series by Ian M Banks\n" +
+			"\n" +
+			"Unlike the `culture_ship` resource, the name is not persisted: a new name is generated every time " +
+			"the data source is read.\n",
+		Attributes: map[string]schema.Attribute{
+			"prefix": schema.StringAttribute{
+				Description: "A string to prefix the name with.",
+				Optional:    true,
+			},
+			"separator": schema.StringAttribute{
+				Description: "The character to separate words in the ship name. Defaults to \"-\"",
+				Optional:    true,
+				Validators:  separatorValidators(),
+			},
+			"id": schema.StringAttribute{
+				Description: "The random ship name.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The generated ship name, without the prefix.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceCultureShip) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config cultureShipDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	separator := "-"
+	if !config.Separator.IsNull() {
+		separator = config.Separator.ValueString()
+	}
+
+	ship := strings.ToLower(spaceships.Generate(separator))
+
+	state := cultureShipDataSourceModel{
+		ID:        types.StringValue(composeID(config.Prefix.ValueString(), ship, "", separator)),
+		Name:      types.StringValue(ship),
+		Prefix:    config.Prefix,
+		Separator: types.StringValue(separator),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+type cultureShipDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Prefix    types.String `tfsdk:"prefix"`
+	Separator types.String `tfsdk:"separator"`
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/matthewbaggett/terraform-provider-fun-names/internal/spaceships"
+)
+
+var _ datasource.DataSource = (*dataSourceCultureShipClasses)(nil)
+
+func NewCultureShipClassesDataSource() datasource.DataSource {
+	return &dataSourceCultureShipClasses{}
+}
+
+type dataSourceCultureShipClasses struct{}
+
+func (d *dataSourceCultureShipClasses) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_culture_ship_classes"
+}
+
+func (d *dataSourceCultureShipClasses) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The data source `culture_ship_classes` lists the ship classes known to the provider, " +
+			"which are the values accepted by the `class` attribute of the `culture_ship` resource.\n",
+		Attributes: map[string]schema.Attribute{
+			"classes": schema.ListAttribute{
+				Description: "The abbreviation of every known ship class, sorted alphabetically.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceCultureShipClasses) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	classes, diags := types.ListValueFrom(ctx, types.StringType, spaceships.Classes())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := cultureShipClassesDataSourceModel{
+		Classes: classes,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+type cultureShipClassesDataSourceModel struct {
+	Classes types.List `tfsdk:"classes"`
+}
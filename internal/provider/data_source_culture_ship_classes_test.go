@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceCultureShipClasses(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `data "fun-names_culture_ship_classes" "classes" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fun-names_culture_ship_classes.classes", "classes.#", "9"),
+					resource.TestCheckResourceAttr("data.fun-names_culture_ship_classes.classes", "classes.0", "GCU"),
+					resource.TestCheckResourceAttr("data.fun-names_culture_ship_classes.classes", "classes.8", "VFP"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/matthewbaggett/terraform-provider-fun-names/internal/spaceships"
+)
+
+var _ datasource.DataSource = (*dataSourceCultureShipPage)(nil)
+
+func NewCultureShipPageDataSource() datasource.DataSource {
+	return &dataSourceCultureShipPage{}
+}
+
+type dataSourceCultureShipPage struct{}
+
+func (d *dataSourceCultureShipPage) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_culture_ship_page"
+}
+
+func (d *dataSourceCultureShipPage) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The data source `culture_ship_page` returns a page of the names of the ships known to the " +
+			"provider, as written in the books and sorted alphabetically, for paging through the whole " +
+			"catalogue. Unlike the other data sources it is not random: the same `offset` and `name_count` " +
+			"always return the same names for the same catalogue.\n",
+		Attributes: map[string]schema.Attribute{
+			"name_count": schema.Int64Attribute{
+				Description: "The most ship names to return in `names`. Must be at least 1. " +
+					"(`count` is reserved by Terraform.)",
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"offset": schema.Int64Attribute{
+				Description: "The number of ship names to skip from the start of the catalogue. Must not be " +
+					"negative. Defaults to 0.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"names": schema.ListAttribute{
+				Description: "Up to `name_count` ship names, starting `offset` names into the sorted catalogue. " +
+					"Shorter than `name_count` on the last page, and empty past the end of the catalogue.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"total": schema.Int64Attribute{
+				Description: "The number of ship names in the catalogue, across all pages.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceCultureShipPage) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config cultureShipPageDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := spaceships.All()
+
+	// Both bounds are clamped to the catalogue, so that reading past the end
+	// gives an empty page rather than an error.
+	total := int64(len(all))
+	start := min(config.Offset.ValueInt64(), total)
+	end := start + min(config.NameCount.ValueInt64(), total-start)
+
+	names, diags := types.ListValueFrom(ctx, types.StringType, all[start:end])
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := cultureShipPageDataSourceModel{
+		NameCount: config.NameCount,
+		Names:     names,
+		Offset:    config.Offset,
+		Total:     types.Int64Value(total),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+type cultureShipPageDataSourceModel struct {
+	NameCount types.Int64 `tfsdk:"name_count"`
+	Names     types.List  `tfsdk:"names"`
+	Offset    types.Int64 `tfsdk:"offset"`
+	Total     types.Int64 `tfsdk:"total"`
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/matthewbaggett/terraform-provider-fun-names/internal/spaceships"
+)
+
+func TestAccDataSourceCultureShipPage(t *testing.T) {
+	total := spaceships.Count()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`data "fun-names_culture_ship_page" "first" {
+							name_count = 2
+						}
+
+						data "fun-names_culture_ship_page" "second" {
+							name_count = 2
+							offset     = 1
+						}
+
+						data "fun-names_culture_ship_page" "last" {
+							name_count = 5
+							offset     = %d
+						}
+
+						data "fun-names_culture_ship_page" "past_end" {
+							name_count = 5
+							offset     = %d
+						}`, total-2, total+10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fun-names_culture_ship_page.first", "total", strconv.Itoa(total)),
+					resource.TestCheckResourceAttr("data.fun-names_culture_ship_page.first", "names.#", "2"),
+					resource.TestCheckResourceAttr("data.fun-names_culture_ship_page.first", "names.0", "A Fine Disregard For Awkward Facts"),
+					resource.TestCheckResourceAttr("data.fun-names_culture_ship_page.first", "names.1", "A Momentary Lapse Of Sanity"),
+					resource.TestCheckResourceAttr("data.fun-names_culture_ship_page.second", "names.#", "2"),
+					resource.TestCheckResourceAttr("data.fun-names_culture_ship_page.second", "names.0", "A Momentary Lapse Of Sanity"),
+					resource.TestCheckResourceAttr("data.fun-names_culture_ship_page.second", "names.1", "A Series Of Unlikely Explanations"),
+					resource.TestCheckResourceAttr("data.fun-names_culture_ship_page.last", "names.#", "2"),
+					resource.TestCheckResourceAttr("data.fun-names_culture_ship_page.last", "names.0", "Zero Gravitas"),
+					resource.TestCheckResourceAttr("data.fun-names_culture_ship_page.last", "names.1", "Zoologist"),
+					resource.TestCheckResourceAttr("data.fun-names_culture_ship_page.past_end", "names.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceCultureShipPage_OffsetNegative(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `data "fun-names_culture_ship_page" "page" {
+							name_count = 5
+							offset     = -1
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute offset value must be at least 0`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceCultureShipPage_NameCountZero(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `data "fun-names_culture_ship_page" "page" {
+							name_count = 0
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute name_count value must be at least 1`),
+			},
+		},
+	})
+}
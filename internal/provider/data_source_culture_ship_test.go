@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceCultureShip(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `data "fun-names_culture_ship" "ship" {
+							prefix    = "gsv"
+							separator = "_"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.fun-names_culture_ship.ship", "id", regexp.MustCompile(`^gsv_[^A-Z ]+$`)),
+					resource.TestCheckResourceAttr("data.fun-names_culture_ship.ship", "separator", "_"),
+					resource.TestCheckResourceAttrSet("data.fun-names_culture_ship.ship", "name"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/matthewbaggett/terraform-provider-fun-names/internal/spaceships"
+)
+
+var _ datasource.DataSource = (*dataSourceCultureShipWeighted)(nil)
+
+func NewCultureShipWeightedDataSource() datasource.DataSource {
+	return &dataSourceCultureShipWeighted{}
+}
+
+type dataSourceCultureShipWeighted struct{}
+
+func (d *dataSourceCultureShipWeighted) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_culture_ship_weighted"
+}
+
+func (d *dataSourceCultureShipWeighted) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The data source `culture_ship_weighted` returns a name of a ship from the Culture Series " +
+			"by Ian M Banks, favouring the ships most prominent in the books.\n" +
+			"\n" +
+			"The weights are editorial tiers chosen by the provider's maintainers, not mention-frequency " +
+			"data, which is not catalogued: ships central to a plot weigh 20, other ships with a known " +
+			"source weigh 5, and the rest, mostly named in passing, weigh 1. A new name is generated every " +
+			"time the data source is read.\n",
+		Attributes: map[string]schema.Attribute{
+			"prefix": schema.StringAttribute{
+				Description: "A string to prefix the name with.",
+				Optional:    true,
+			},
+			"separator": schema.StringAttribute{
+				Description: "The character to separate words in the ship name. Defaults to \"-\"",
+				Optional:    true,
+				Validators:  separatorValidators(),
+			},
+			"id": schema.StringAttribute{
+				Description: "The random ship name.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The generated ship name, without the prefix.",
+				Computed:    true,
+			},
+			"weight": schema.Int64Attribute{
+				Description: "The weight of the editorial tier the ship was drawn from: 20, 5 or 1.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceCultureShipWeighted) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config cultureShipWeightedDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	separator := "-"
+	if !config.Separator.IsNull() {
+		separator = config.Separator.ValueString()
+	}
+
+	generated, err := spaceships.GenerateByTier(separator, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Ship Name Generation Error",
+			fmt.Sprintf("Unable to generate a ship name: %s.", err),
+		)
+		return
+	}
+
+	ship := strings.ToLower(generated.Name)
+
+	state := cultureShipWeightedDataSourceModel{
+		ID:        types.StringValue(composeID(config.Prefix.ValueString(), ship, "", separator)),
+		Name:      types.StringValue(ship),
+		Prefix:    config.Prefix,
+		Separator: types.StringValue(separator),
+		Weight:    types.Int64Value(int64(generated.TierWeight)),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+type cultureShipWeightedDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Prefix    types.String `tfsdk:"prefix"`
+	Separator types.String `tfsdk:"separator"`
+	Weight    types.Int64  `tfsdk:"weight"`
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/matthewbaggett/terraform-provider-fun-names/internal/spaceships"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = (*ephemeralCultureShip)(nil)
+	_ ephemeral.EphemeralResourceWithClose     = (*ephemeralCultureShip)(nil)
+	_ ephemeral.EphemeralResourceWithConfigure = (*ephemeralCultureShip)(nil)
+	_ ephemeral.EphemeralResourceWithRenew     = (*ephemeralCultureShip)(nil)
+)
+
+func NewCultureShipEphemeralResource() ephemeral.EphemeralResource {
+	return &ephemeralCultureShip{}
+}
+
+type ephemeralCultureShip struct {
+	providerData providerData
+}
+
+func (e *ephemeralCultureShip) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.providerData = *data
+}
+
+func (e *ephemeralCultureShip) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_culture_ship"
+}
+
+func (e *ephemeralCultureShip) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The ephemeral resource `culture_ship` returns a name of a ship from the Culture Series by Ian M Banks\n" +
+			"\n" +
+			"Unlike the `culture_ship` resource, the name is never stored in the plan or state, which makes it " +
+			"suitable for throwaway identifiers passed to write-only arguments. Requires Terraform 1.10 or later, " +
+			"and Terraform 1.11 or later for write-only arguments.\n",
+		Attributes: map[string]schema.Attribute{
+			"prefix": schema.StringAttribute{
+				Description: "A string to prefix the name with.",
+				Optional:    true,
+			},
+			"separator": schema.StringAttribute{
+				Description: "The characters to separate words in the ship name, and to join the prefix to it. " +
+					"Defaults to the provider's `default_separator`, or \"-\" if that is not set either.",
+				Optional:   true,
+				Computed:   true,
+				Validators: separatorValidators(),
+			},
+			"id": schema.StringAttribute{
+				Description: "The random ship name.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The generated ship name, without the prefix.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralCultureShip) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config cultureShipEphemeralModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	separator := e.providerData.defaultSeparator
+	if !config.Separator.IsNull() {
+		separator = config.Separator.ValueString()
+	}
+
+	var ship string
+	if rnd := e.providerData.newRand(); rnd != nil {
+		ship = spaceships.GenerateWithRand(separator, rnd).Name
+	} else {
+		ship = spaceships.Generate(separator)
+	}
+	ship = strings.ToLower(ship)
+
+	result := cultureShipEphemeralModel{
+		ID:        types.StringValue(composeID(config.Prefix.ValueString(), ship, "", separator)),
+		Name:      types.StringValue(ship),
+		Prefix:    config.Prefix,
+		Separator: types.StringValue(separator),
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, result)...)
+}
+
+// Renew does nothing, as the name holds no lease to renew. Open never sets
+// RenewAt, so Terraform does not call it.
+func (e *ephemeralCultureShip) Renew(_ context.Context, _ ephemeral.RenewRequest, _ *ephemeral.RenewResponse) {
+}
+
+// Close does nothing, as the name holds nothing that needs releasing.
+func (e *ephemeralCultureShip) Close(_ context.Context, _ ephemeral.CloseRequest, _ *ephemeral.CloseResponse) {
+}
+
+type cultureShipEphemeralModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Prefix    types.String `tfsdk:"prefix"`
+	Separator types.String `tfsdk:"separator"`
+}
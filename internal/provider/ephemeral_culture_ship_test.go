@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccEphemeralCultureShip(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		// The echo provider surfaces the ephemeral result so it can be
+		// checked, as ephemeral resources have no state of their own.
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `ephemeral "fun-names_culture_ship" "ship" {
+							prefix    = "gsv"
+							separator = "_"
+						}
+
+						provider "echo" {
+							data = ephemeral.fun-names_culture_ship.ship
+						}
+
+						resource "echo" "ship" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("echo.ship", "data.id", regexp.MustCompile(`^gsv_[^A-Z -]+$`)),
+					resource.TestCheckResourceAttr("echo.ship", "data.separator", "_"),
+					resource.TestCheckResourceAttrSet("echo.ship", "data.name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEphemeralCultureShip_WriteOnly(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				// The name is composed into another value, as it would be
+				// for a write-only argument, and must stay usable there.
+				Config: `ephemeral "fun-names_culture_ship" "ship" {
+							separator = "-"
+						}
+
+						provider "echo" {
+							data = {
+								secret = "gsv-${ephemeral.fun-names_culture_ship.ship.id}"
+							}
+						}
+
+						resource "echo" "ship" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("echo.ship", "data.secret", regexp.MustCompile(`^gsv-[^A-Z ]+$`)),
+				),
+			},
+		},
+	})
+}
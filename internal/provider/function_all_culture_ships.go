@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/matthewbaggett/terraform-provider-fun-names/internal/spaceships"
+)
+
+var _ function.Function = (*allCultureShipsFunction)(nil)
+
+func NewAllCultureShipsFunction() function.Function {
+	return &allCultureShipsFunction{}
+}
+
+type allCultureShipsFunction struct{}
+
+func (f *allCultureShipsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "all_culture_ships"
+}
+
+func (f *allCultureShipsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "List every known ship from the Culture Series by Ian M Banks",
+		Description: "Returns the name of every ship known to the provider, as written in the books, sorted alphabetically.",
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *allCultureShipsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	resp.Error = resp.Result.Set(ctx, spaceships.All())
+}
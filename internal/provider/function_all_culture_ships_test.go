@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionAllCultureShips(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `output "ships" {
+							value = provider::fun-names::all_culture_ships()
+						}
+
+						output "contains_sleeper_service" {
+							value = contains(provider::fun-names::all_culture_ships(), "Sleeper Service")
+						}`,
+				Check: resource.TestCheckOutput("contains_sleeper_service", "true"),
+			},
+		},
+	})
+}
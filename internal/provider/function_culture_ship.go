@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/matthewbaggett/terraform-provider-fun-names/internal/spaceships"
+)
+
+var _ function.Function = (*cultureShipFunction)(nil)
+
+func NewCultureShipFunction() function.Function {
+	return &cultureShipFunction{}
+}
+
+type cultureShipFunction struct{}
+
+func (f *cultureShipFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "culture_ship"
+}
+
+func (f *cultureShipFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Generate a name of a ship from the Culture Series by Ian M Banks",
+		Description: "Returns a lowercase ship name, with its words joined by the optional separator, which " +
+			"defaults to \"-\".\n\n" +
+			"Unlike the `culture_ship` resource, the name is not persisted: a different name is returned every " +
+			"time the function is called, including between plan and apply. It is best used where the value " +
+			"does not need to be stable, such as in ephemeral contexts.",
+		VariadicParameter: function.StringParameter{
+			Name:        "separator",
+			Description: "The character to separate words in the ship name. Defaults to \"-\".",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *cultureShipFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var separators []string
+
+	resp.Error = req.Arguments.Get(ctx, &separators)
+	if resp.Error != nil {
+		return
+	}
+
+	separator := "-"
+	switch len(separators) {
+	case 0:
+	case 1:
+		separator = separators[0]
+	default:
+		resp.Error = function.NewArgumentFuncError(0, "At most one separator may be given.")
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, strings.ToLower(spaceships.Generate(separator)))
+}
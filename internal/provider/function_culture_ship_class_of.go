@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/matthewbaggett/terraform-provider-fun-names/internal/spaceships"
+)
+
+var _ function.Function = (*cultureShipClassOfFunction)(nil)
+
+func NewCultureShipClassOfFunction() function.Function {
+	return &cultureShipClassOfFunction{}
+}
+
+type cultureShipClassOfFunction struct{}
+
+func (f *cultureShipClassOfFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "culture_ship_class_of"
+}
+
+func (f *cultureShipClassOfFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Look up the class of a ship from the Culture Series by Ian M Banks",
+		Description: "Returns the abbreviation of the class of a known ship, such as `GSV`, or null if the name " +
+			"is not a known ship or its class is not known. Names are matched as `culture_ship_matches` " +
+			"matches them, ignoring case and separators.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "The name to look up.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *cultureShipClassOfFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+
+	resp.Error = req.Arguments.Get(ctx, &name)
+	if resp.Error != nil {
+		return
+	}
+
+	class, ok := spaceships.ClassOf(name)
+	if !ok {
+		resp.Error = resp.Result.Set(ctx, types.StringNull())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, class)
+}
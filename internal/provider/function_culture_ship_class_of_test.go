@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionCultureShipClassOf(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `output "exact" {
+							value = provider::fun-names::culture_ship_class_of("Sleeper Service")
+						}
+
+						output "separator" {
+							value = provider::fun-names::culture_ship_class_of("grey-area")
+						}
+
+						output "unknown_class" {
+							value = provider::fun-names::culture_ship_class_of("Ablation") == null
+						}
+
+						output "unknown_ship" {
+							value = provider::fun-names::culture_ship_class_of("Not A Real Ship") == null
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("exact", "GSV"),
+					resource.TestCheckOutput("separator", "GCU"),
+					resource.TestCheckOutput("unknown_class", "true"),
+					resource.TestCheckOutput("unknown_ship", "true"),
+				),
+			},
+		},
+	})
+}
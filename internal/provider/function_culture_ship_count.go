@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/matthewbaggett/terraform-provider-fun-names/internal/spaceships"
+)
+
+var _ function.Function = (*cultureShipCountFunction)(nil)
+
+func NewCultureShipCountFunction() function.Function {
+	return &cultureShipCountFunction{}
+}
+
+type cultureShipCountFunction struct{}
+
+func (f *cultureShipCountFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "culture_ship_count"
+}
+
+func (f *cultureShipCountFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Count the known ships from the Culture Series by Ian M Banks",
+		Description: "Returns the number of distinct ship names known to the provider, which is the length of the " +
+			"list returned by `all_culture_ships`.",
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *cultureShipCountFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	resp.Error = resp.Result.Set(ctx, types.Int64Value(int64(spaceships.Count())))
+}
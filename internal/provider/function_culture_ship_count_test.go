@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionCultureShipCount(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `output "count" {
+							value = provider::fun-names::culture_ship_count()
+						}
+
+						output "matches_all" {
+							value = provider::fun-names::culture_ship_count() == length(provider::fun-names::all_culture_ships())
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("count", "212"),
+					resource.TestCheckOutput("matches_all", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/matthewbaggett/terraform-provider-fun-names/internal/spaceships"
+)
+
+var _ function.Function = (*cultureShipForFunction)(nil)
+
+func NewCultureShipForFunction() function.Function {
+	return &cultureShipForFunction{}
+}
+
+type cultureShipForFunction struct{}
+
+func (f *cultureShipForFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "culture_ship_for"
+}
+
+func (f *cultureShipForFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Pick the name of a ship from the Culture Series by Ian M Banks for a seed",
+		Description: "Returns a lowercase ship name, picked by hashing the seed and with its words joined by the " +
+			"separator.\n\n" +
+			"Unlike the `culture_ship` function, the same seed always returns the same name, so a stable name " +
+			"can be derived from an input such as a project id without managing resource state. The name for a " +
+			"seed only changes if the provider's list of known ships does.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "seed",
+				Description: "The string to pick the ship name by.",
+			},
+			function.StringParameter{
+				Name:        "separator",
+				Description: "The characters to separate words in the ship name.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *cultureShipForFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var seed, separator string
+
+	resp.Error = req.Arguments.Get(ctx, &seed, &separator)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, strings.ToLower(spaceships.GenerateForSeed(seed, separator)))
+}
@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/matthewbaggett/terraform-provider-fun-names/internal/spaceships"
+)
+
+func TestAccFunctionCultureShipFor(t *testing.T) {
+	want := strings.ToLower(spaceships.GenerateForSeed("project-1234", "_"))
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `output "ship" {
+							value = provider::fun-names::culture_ship_for("project-1234", "_")
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("ship", want),
+					resource.TestMatchOutput("ship", regexp.MustCompile(`^[^A-Z ]+$`)),
+				),
+			},
+		},
+	})
+}
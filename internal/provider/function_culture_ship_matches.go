@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/matthewbaggett/terraform-provider-fun-names/internal/spaceships"
+)
+
+var _ function.Function = (*cultureShipMatchesFunction)(nil)
+
+func NewCultureShipMatchesFunction() function.Function {
+	return &cultureShipMatchesFunction{}
+}
+
+type cultureShipMatchesFunction struct{}
+
+func (f *cultureShipMatchesFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "culture_ship_matches"
+}
+
+func (f *cultureShipMatchesFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check whether a name is a known ship from the Culture Series by Ian M Banks",
+		Description: "Returns true if the name is a known ship. Case is ignored, and any run of punctuation, " +
+			"whitespace or separator characters is treated as the break between two words, so names generated " +
+			"with any separator or case, and their slugs, all match.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "The name to look for.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *cultureShipMatchesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+
+	resp.Error = req.Arguments.Get(ctx, &name)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, spaceships.Contains(name))
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionCultureShipMatches(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `output "exact" {
+							value = provider::fun-names::culture_ship_matches("Sleeper Service")
+						}
+
+						output "case" {
+							value = provider::fun-names::culture_ship_matches("sLEEPER sERVICE")
+						}
+
+						output "separator" {
+							value = provider::fun-names::culture_ship_matches("sleeper_service")
+						}
+
+						output "unknown" {
+							value = provider::fun-names::culture_ship_matches("Not A Real Ship")
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("exact", "true"),
+					resource.TestCheckOutput("case", "true"),
+					resource.TestCheckOutput("separator", "true"),
+					resource.TestCheckOutput("unknown", "false"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/matthewbaggett/terraform-provider-fun-names/internal/spaceships"
+)
+
+var _ function.Function = (*cultureShipsFunction)(nil)
+
+func NewCultureShipsFunction() function.Function {
+	return &cultureShipsFunction{}
+}
+
+type cultureShipsFunction struct{}
+
+func (f *cultureShipsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "culture_ships"
+}
+
+func (f *cultureShipsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Generate several distinct names of ships from the Culture Series by Ian M Banks",
+		Description: "Returns a list of `count` distinct lowercase ship names, with their words joined by the " +
+			"optional separator, which defaults to \"-\". `count` must not exceed `culture_ship_count()`.\n\n" +
+			"Like `culture_ship`, the names are not persisted: different names are returned every time the " +
+			"function is called, including between plan and apply.",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:        "count",
+				Description: "The number of distinct ship names to generate.",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "separator",
+			Description: "The character to separate words in the ship names. Defaults to \"-\".",
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *cultureShipsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var count int64
+	var separators []string
+
+	resp.Error = req.Arguments.Get(ctx, &count, &separators)
+	if resp.Error != nil {
+		return
+	}
+
+	if count < 0 {
+		resp.Error = function.NewArgumentFuncError(0, "The count must not be negative.")
+		return
+	}
+
+	if known := int64(spaceships.Count()); count > known {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf(
+			"Unable to generate %d distinct ship names: only %d ship names are known.", count, known))
+		return
+	}
+
+	separator := "-"
+	switch len(separators) {
+	case 0:
+	case 1:
+		separator = separators[0]
+	default:
+		resp.Error = function.NewArgumentFuncError(1, "At most one separator may be given.")
+		return
+	}
+
+	names, err := spaceships.GenerateDistinct(separator, int(count), nil)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	for i := range names {
+		names[i] = strings.ToLower(names[i])
+	}
+
+	resp.Error = resp.Result.Set(ctx, names)
+}
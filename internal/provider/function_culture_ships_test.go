@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionCultureShips(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `locals {
+							ships = provider::fun-names::culture_ships(5, "_")
+						}
+
+						output "count" {
+							value = length(local.ships)
+						}
+
+						output "distinct" {
+							value = length(distinct(local.ships))
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("count", "5"),
+					resource.TestCheckOutput("distinct", "5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFunctionCultureShips_TooMany(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `output "ships" {
+							value = provider::fun-names::culture_ships(provider::fun-names::culture_ship_count() + 1)
+						}`,
+				ExpectError: regexp.MustCompile(`only \d+ ship names are known`),
+			},
+		},
+	})
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/matthewbaggett/terraform-provider-fun-names/internal/spaceships"
+)
+
+var _ function.Function = (*formatCultureShipFunction)(nil)
+
+func NewFormatCultureShipFunction() function.Function {
+	return &formatCultureShipFunction{}
+}
+
+type formatCultureShipFunction struct{}
+
+func (f *formatCultureShipFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "format_culture_ship"
+}
+
+func (f *formatCultureShipFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Reformat a ship name with a different separator and case",
+		Description: "Returns the name with its words rejoined by `separator` and recased by `case`, as the " +
+			"`culture_ship` resource would have generated it. The separator the name was joined with is guessed " +
+			"the same way as when importing a `culture_ship`, so names using a separator other than \"-\", \"_\" " +
+			"or a space are treated as a single word. When the name contains a known ship, `original` case " +
+			"restores the casing used in the books for it; any words before or after it are kept as given.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "The ship name to reformat.",
+			},
+			function.DynamicParameter{
+				Name: "options",
+				Description: "An object with the optional attributes `separator`, which defaults to \"-\", and " +
+					"`case`, one of `lower`, `upper`, `title` or `original`, which defaults to `lower`. " +
+					"May be null to use both defaults.",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *formatCultureShipFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	var options types.Dynamic
+
+	resp.Error = req.Arguments.Get(ctx, &name, &options)
+	if resp.Error != nil {
+		return
+	}
+
+	separator, mode := "-", caseLower
+
+	var attributes map[string]attr.Value
+	switch value := options.UnderlyingValue().(type) {
+	case nil:
+	case types.Object:
+		attributes = value.Attributes()
+	case types.Map:
+		attributes = value.Elements()
+	default:
+		resp.Error = function.NewArgumentFuncError(1, "The options must be an object.")
+		return
+	}
+
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value, ok := attributes[key].(types.String)
+		if !ok {
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("The %q option must be a string.", key))
+			return
+		}
+
+		if value.IsNull() {
+			continue
+		}
+
+		switch key {
+		case "separator":
+			separator = value.ValueString()
+		case "case":
+			mode = value.ValueString()
+		default:
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf(
+				"Unsupported option %q: only \"separator\" and \"case\" may be given.", key))
+			return
+		}
+	}
+
+	if !separatorRegexp.MatchString(separator) || !separatorPrintableRegexp.MatchString(separator) {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf(
+			"The separator must not contain letters, digits, control characters or whitespace other than "+
+				"spaces, got: %q.", separator))
+		return
+	}
+
+	switch mode {
+	case caseLower, caseUpper, caseTitle, caseOriginal:
+	default:
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf(
+			"The case must be one of %q, %q, %q or %q, got: %q.", caseLower, caseUpper, caseTitle, caseOriginal, mode))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, formatShipName(name, separator, mode))
+}
+
+// formatShipName rejoins the words of name, which may include a prefix and
+// suffix, with the separator and applies the case mode to them. The ship
+// within name is found as ImportState finds it, and takes the words as written
+// in the books, so that the original case mode restores their casing.
+func formatShipName(name, separator, mode string) string {
+	from := detectSeparator(spaceships.CatalogueGenerator{}, name)
+	prefix, ship, suffix, known := splitImportID(spaceships.CatalogueGenerator{}, name, from)
+
+	var words []string
+	if prefix != "" {
+		words = append(words, strings.Split(prefix, from)...)
+	}
+	if known.Name != "" {
+		words = append(words, known.Words...)
+	} else if ship != "" {
+		words = append(words, strings.Split(ship, from)...)
+	}
+	if suffix != "" {
+		words = append(words, strings.Split(suffix, from)...)
+	}
+
+	return applyCase(strings.Join(words, separator), separator, mode)
+}
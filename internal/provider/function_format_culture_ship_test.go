@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionFormatCultureShip(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `output "defaults" {
+							value = provider::fun-names::format_culture_ship("Sleeper Service", null)
+						}
+
+						output "separator" {
+							value = provider::fun-names::format_culture_ship("sleeper-service", { separator = "_" })
+						}
+
+						output "original" {
+							value = provider::fun-names::format_culture_ship("env_of_course_i_still_love_you_01", {
+								separator = " "
+								case      = "original"
+							})
+						}
+
+						output "unknown" {
+							value = provider::fun-names::format_culture_ship("not-a-real-ship", { case = "upper" })
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("defaults", "sleeper-service"),
+					resource.TestCheckOutput("separator", "sleeper_service"),
+					resource.TestCheckOutput("original", "env Of Course I Still Love You 01"),
+					resource.TestCheckOutput("unknown", "NOT-A-REAL-SHIP"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFunctionFormatCultureShip_InvalidCase(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `output "test" {
+							value = provider::fun-names::format_culture_ship("sleeper-service", { case = "shouty" })
+						}`,
+				ExpectError: regexp.MustCompile(`The case must be one of`),
+			},
+		},
+	})
+}
+
+func TestAccFunctionFormatCultureShip_UnsupportedOption(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `output "test" {
+							value = provider::fun-names::format_culture_ship("sleeper-service", { prefix = "env" })
+						}`,
+				ExpectError: regexp.MustCompile(`Unsupported option "prefix"`),
+			},
+		},
+	})
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/matthewbaggett/terraform-provider-fun-names/internal/spaceships"
+)
+
+var _ function.Function = (*parseCultureShipFunction)(nil)
+
+func NewParseCultureShipFunction() function.Function {
+	return &parseCultureShipFunction{}
+}
+
+type parseCultureShipFunction struct{}
+
+func (f *parseCultureShipFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_culture_ship"
+}
+
+func (f *parseCultureShipFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Split a ship name into its class and the rest of the name",
+		Description: "Returns an object with the `class` abbreviation a ship name begins with, such as \"GSV\" " +
+			"for \"GSV Sleeper Service\", and the rest of the `name`. The class is matched case-insensitively " +
+			"against the abbreviations returned by the `culture_ship_classes` data source, must be a whole word " +
+			"and must be followed by more of the name. The separator after the class is dropped and the rest " +
+			"of the name is returned unchanged. If the name does not begin with a class, `class` is null and " +
+			"`name` is the whole name.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "The name to split.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parsedCultureShipAttributeTypes,
+		},
+	}
+}
+
+func (f *parseCultureShipFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+
+	resp.Error = req.Arguments.Get(ctx, &name)
+	if resp.Error != nil {
+		return
+	}
+
+	class, rest := spaceships.ParseClass(name)
+
+	parsed := parsedCultureShip{
+		Class: types.StringNull(),
+		Name:  types.StringValue(rest),
+	}
+	if class != "" {
+		parsed.Class = types.StringValue(class)
+	}
+
+	resp.Error = resp.Result.Set(ctx, parsed)
+}
+
+var parsedCultureShipAttributeTypes = map[string]attr.Type{
+	"class": types.StringType,
+	"name":  types.StringType,
+}
+
+type parsedCultureShip struct {
+	Class types.String `tfsdk:"class"`
+	Name  types.String `tfsdk:"name"`
+}
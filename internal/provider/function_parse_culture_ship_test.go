@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionParseCultureShip(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `locals {
+							classed   = provider::fun-names::parse_culture_ship("gsv-sleeper-service")
+							unclassed = provider::fun-names::parse_culture_ship("Sleeper Service")
+						}
+
+						output "class" {
+							value = local.classed.class
+						}
+
+						output "name" {
+							value = local.classed.name
+						}
+
+						output "no_class" {
+							value = local.unclassed.class == null
+						}
+
+						output "whole_name" {
+							value = local.unclassed.name
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("class", "GSV"),
+					resource.TestCheckOutput("name", "sleeper-service"),
+					resource.TestCheckOutput("no_class", "true"),
+					resource.TestCheckOutput("whole_name", "Sleeper Service"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*slugifyFunction)(nil)
+
+func NewSlugifyFunction() function.Function {
+	return &slugifyFunction{}
+}
+
+type slugifyFunction struct{}
+
+func (f *slugifyFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "slugify"
+}
+
+func (f *slugifyFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Turn a string into a URL-safe slug",
+		Description: "Returns the input lowercased, with every run of characters other than letters and digits " +
+			"replaced by a single separator and no separator at either end. With the default separator, \"-\", " +
+			"this is the same normalisation used for the `slug` attribute of the `culture_ship` resource.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to slugify.",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "separator",
+			Description: "The characters to join the words of the slug with. Defaults to \"-\".",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *slugifyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var separators []string
+
+	resp.Error = req.Arguments.Get(ctx, &input, &separators)
+	if resp.Error != nil {
+		return
+	}
+
+	separator := "-"
+	switch len(separators) {
+	case 0:
+	case 1:
+		separator = separators[0]
+	default:
+		resp.Error = function.NewArgumentFuncError(1, "At most one separator may be given.")
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, slugify(input, separator))
+}
@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionSlugify(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `output "default" {
+							value = provider::fun-names::slugify("  Funny, It Worked Last Time...")
+						}
+
+						output "separator" {
+							value = provider::fun-names::slugify("Don't Try This At Home", "_")
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("default", "funny-it-worked-last-time"),
+					resource.TestCheckOutput("separator", "don_t_try_this_at_home"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*slugsCollideFunction)(nil)
+
+func NewSlugsCollideFunction() function.Function {
+	return &slugsCollideFunction{}
+}
+
+type slugsCollideFunction struct{}
+
+func (f *slugsCollideFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "slugs_collide"
+}
+
+func (f *slugsCollideFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check whether two strings have the same slug",
+		Description: "Returns true if `slugify` gives the same slug for both inputs, for example for names that " +
+			"differ only in case or punctuation, so that they would clash once used in a DNS name or URL. " +
+			"The choice of separator makes no difference to whether slugs collide.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "a",
+				Description: "The first string to compare.",
+			},
+			function.StringParameter{
+				Name:        "b",
+				Description: "The second string to compare.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *slugsCollideFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var a, b string
+
+	resp.Error = req.Arguments.Get(ctx, &a, &b)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, slugify(a, "-") == slugify(b, "-"))
+}
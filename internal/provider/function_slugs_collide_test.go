@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionSlugsCollide(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `output "punctuation" {
+							value = provider::fun-names::slugs_collide("Funny, It Worked Last Time...", "funny-it-worked-last-time")
+						}
+
+						output "case_and_separator" {
+							value = provider::fun-names::slugs_collide("Sleeper Service", "SLEEPER_SERVICE")
+						}
+
+						output "apostrophe" {
+							value = provider::fun-names::slugs_collide("Don't Try This At Home", "Dont Try This At Home")
+						}
+
+						output "different" {
+							value = provider::fun-names::slugs_collide("Sleeper Service", "Grey Area")
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("punctuation", "true"),
+					resource.TestCheckOutput("case_and_separator", "true"),
+					resource.TestCheckOutput("apostrophe", "false"),
+					resource.TestCheckOutput("different", "false"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// levenshtein returns the number of single rune insertions, deletions and
+// substitutions needed to turn a into b. Only two rows of the distance matrix
+// are kept, so it needs memory in proportion to the shorter string.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) < len(rb) {
+		ra, rb = rb, ra
+	}
+	if len(rb) == 0 {
+		return len(ra)
+	}
+
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i, ca := range ra {
+		current[0] = i + 1
+		for j, cb := range rb {
+			cost := 1
+			if ca == cb {
+				cost = 0
+			}
+			current[j+1] = min(previous[j+1]+1, current[j]+1, previous[j]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(rb)]
+}
+
+// withinDistance reports whether name is fewer than minDistance edits from
+// any of names.
+func withinDistance(name string, names []string, minDistance int) bool {
+	for _, other := range names {
+		if levenshtein(name, other) < minDistance {
+			return true
+		}
+	}
+	return false
+}
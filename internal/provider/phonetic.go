@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"unicode"
+)
+
+// phoneticLetters are the NATO phonetic alphabet code words, from Alpha for
+// 'a' to Zulu for 'z'.
+var phoneticLetters = [...]string{
+	"Alpha", "Bravo", "Charlie", "Delta", "Echo", "Foxtrot", "Golf", "Hotel", "India",
+	"Juliet", "Kilo", "Lima", "Mike", "November", "Oscar", "Papa", "Quebec", "Romeo",
+	"Sierra", "Tango", "Uniform", "Victor", "Whiskey", "X-ray", "Yankee", "Zulu",
+}
+
+// phoneticDigits are the spoken words for the digits 0 to 9.
+var phoneticDigits = [...]string{
+	"Zero", "One", "Two", "Three", "Four", "Five", "Six", "Seven", "Eight", "Nine",
+}
+
+// phoneticSymbols are the spoken words for the punctuation found in ship
+// names and separators.
+var phoneticSymbols = map[rune]string{
+	' ':  "space",
+	'!':  "exclamation",
+	'\'': "apostrophe",
+	',':  "comma",
+	'-':  "dash",
+	'.':  "dot",
+	'/':  "slash",
+	':':  "colon",
+	'?':  "question",
+	'_':  "underscore",
+}
+
+// phonetic spells s out in the NATO phonetic alphabet, one space-separated
+// word per character. Letters are spelt regardless of their case and digits
+// are given as words. Punctuation listed in phoneticSymbols is given by its
+// name, and any other character is kept as it is.
+func phonetic(s string) string {
+	words := make([]string, 0, len(s))
+
+	for _, r := range s {
+		lower := unicode.ToLower(r)
+
+		switch {
+		case lower >= 'a' && lower <= 'z':
+			words = append(words, phoneticLetters[lower-'a'])
+		case r >= '0' && r <= '9':
+			words = append(words, phoneticDigits[r-'0'])
+		case phoneticSymbols[r] != "":
+			words = append(words, phoneticSymbols[r])
+		default:
+			words = append(words, string(r))
+		}
+	}
+
+	return strings.Join(words, " ")
+}
@@ -1,37 +1,334 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+	"sync"
+	"unicode/utf8"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/matthewbaggett/terraform-provider-fun-names/internal/spaceships"
 )
 
 func New() provider.Provider {
 	return &randomProvider{}
 }
 
-var _ provider.Provider = (*randomProvider)(nil)
+var (
+	_ provider.Provider                       = (*randomProvider)(nil)
+	_ provider.ProviderWithEphemeralResources = (*randomProvider)(nil)
+	_ provider.ProviderWithFunctions          = (*randomProvider)(nil)
+)
 
-type randomProvider struct{}
+type randomProvider struct {
+	// ships, if set, replaces spaceships.CatalogueGenerator when generating
+	// ship names, so that tests can make them predictable.
+	ships spaceships.Generator
+}
 
 func (p *randomProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "fun-names"
 }
 
-func (p *randomProvider) Schema(context.Context, provider.SchemaRequest, *provider.SchemaResponse) {
+func (p *randomProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"catalogue_path": schema.StringAttribute{
+				Description: "The path to a file of ship names that resources generate names from instead of " +
+					"the ships of the books. The file is either a JSON array of strings or plain text with one " +
+					"name per line, with words separated by spaces. Blank lines are ignored. Names that are " +
+					"also ships of the books keep their class and source; `canonical_only` rejects any others.",
+				Optional: true,
+			},
+			"default_separator": schema.StringAttribute{
+				Description: "The separator used by `culture_ship` resources that do not set `separator`. " +
+					"Defaults to \"-\". Changing it does not replace existing resources.",
+				Optional:   true,
+				Validators: separatorValidators(),
+			},
+			"ensure_unique": schema.BoolAttribute{
+				Description: "When true, resources never hand out a name that another resource of this provider " +
+					"configuration has already generated during the same Terraform run. Uniqueness is only " +
+					"tracked within a single provider configuration: names generated by separate runs, or by " +
+					"resources of another aliased configuration, may still repeat.",
+				Optional: true,
+			},
+			"extra_names": schema.ListAttribute{
+				Description: "Ship names, with words separated by spaces, that resources generate names from in " +
+					"addition to the ships of the books, or of `catalogue_path` if it is set. Names that only " +
+					"differ from a ship already in the catalogue by case, punctuation or whitespace are ignored. " +
+					"The class and source of the extra names are not known, so they are only generated by " +
+					"resources that do not ask for a `class`, and never by those with `canonical_only`.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"recent_window": schema.Int64Attribute{
+				Description: "The number of most recently generated names that `culture_ship` resources avoid " +
+					"repeating, across every resource of this provider. A name may be generated again once it has " +
+					"dropped out of the window, so this only makes near-duplicates in large applies less likely; " +
+					"use `ensure_unique` to rule out repeats altogether. Defaults to 0, which disables the window.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"seed": schema.Int64Attribute{
+				Description: "A seed that makes every name generated by this provider deterministic. Each resource " +
+					"without a seed of its own draws from a source seeded with this value plus the number of " +
+					"resources that drew from it before in the same run, so names are only reproducible given " +
+					"the same resources created in the same order. Terraform creates resources concurrently, " +
+					"so run with `-parallelism=1` for a stable order. When unset, names are chosen at random.",
+				Optional: true,
+			},
+			"single_character_separator": schema.BoolAttribute{
+				Description: "When true, resources reject any `separator` that is not exactly one character long, " +
+					"matching the behaviour of `random_pet`.",
+				Optional: true,
+			},
+		},
+	}
 }
 
-func (p *randomProvider) Configure(context.Context, provider.ConfigureRequest, *provider.ConfigureResponse) {
+func (p *randomProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var config providerModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := &providerData{
+		defaultSeparator:         "-",
+		recent:                   spaceships.NewRecentWindow(int(config.RecentWindow.ValueInt64())),
+		ships:                    p.ships,
+		singleCharacterSeparator: config.SingleCharacterSeparator.ValueBool(),
+	}
+
+	if config.EnsureUnique.ValueBool() {
+		data.registry = spaceships.NewRegistry()
+	}
+
+	if !config.DefaultSeparator.IsNull() {
+		data.defaultSeparator = config.DefaultSeparator.ValueString()
+	}
+
+	var extraNames []string
+	if !config.ExtraNames.IsNull() {
+		resp.Diagnostics.Append(config.ExtraNames.ElementsAs(ctx, &extraNames, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !config.CataloguePath.IsNull() || len(extraNames) > 0 {
+		names := spaceships.All()
+		if !config.CataloguePath.IsNull() {
+			cataloguePath := config.CataloguePath.ValueString()
+
+			var err error
+			names, err = loadCatalogue(cataloguePath)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("catalogue_path"),
+					"Unable to Load Catalogue",
+					fmt.Sprintf("Unable to load ship names from %q: %s.", cataloguePath, err),
+				)
+				return
+			}
+		}
+
+		// The catalogue comes first, so that an extra name duplicating one of
+		// its ships is the one ignored
+		names = append(names, extraNames...)
+
+		// Without catalogue_path, the extra names are added to the ships of the
+		// books, so only a catalogue file can leave no names at all
+		ships, err := spaceships.NewListGenerator(names)
+		if err != nil {
+			detail := fmt.Sprintf("The file %q does not contain any ship names.", config.CataloguePath.ValueString())
+			if len(extraNames) > 0 {
+				detail = fmt.Sprintf("Neither the file %q nor extra_names contains any ship names.", config.CataloguePath.ValueString())
+			}
+
+			resp.Diagnostics.AddAttributeError(path.Root("catalogue_path"), "Empty Catalogue", detail)
+			return
+		}
+		data.ships = ships
+	}
+
+	if data.ships == nil {
+		data.ships = spaceships.CatalogueGenerator{}
+	}
+
+	data.catalogueFingerprint = catalogueFingerprint(data.ships.All())
+
+	if !config.Seed.IsNull() {
+		data.seeds = &seedSequence{next: config.Seed.ValueInt64()}
+	}
+
+	resp.DataSourceData = data
+	resp.EphemeralResourceData = data
+	resp.ResourceData = data
 }
 
 func (p *randomProvider) Resources(context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewCultureCharacterResource,
+		NewCultureDroneResource,
+		NewCultureGCUResource,
+		NewCultureGSVResource,
 		NewCultureShipResource,
+		NewMindResource,
+		NewOrbitalResource,
 	}
 }
 
 func (p *randomProvider) DataSources(context.Context) []func() datasource.DataSource {
-	return nil
+	return []func() datasource.DataSource{
+		NewCultureShipDataSource,
+		NewCultureShipClassesDataSource,
+		NewCultureShipPageDataSource,
+		NewCultureShipWeightedDataSource,
+	}
+}
+
+func (p *randomProvider) EphemeralResources(context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewCultureShipEphemeralResource,
+	}
+}
+
+func (p *randomProvider) Functions(context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewAllCultureShipsFunction,
+		NewCultureShipClassOfFunction,
+		NewCultureShipCountFunction,
+		NewCultureShipForFunction,
+		NewCultureShipFunction,
+		NewCultureShipMatchesFunction,
+		NewCultureShipsFunction,
+		NewFormatCultureShipFunction,
+		NewParseCultureShipFunction,
+		NewSlugifyFunction,
+		NewSlugsCollideFunction,
+	}
+}
+
+type providerModel struct {
+	CataloguePath            types.String `tfsdk:"catalogue_path"`
+	DefaultSeparator         types.String `tfsdk:"default_separator"`
+	EnsureUnique             types.Bool   `tfsdk:"ensure_unique"`
+	ExtraNames               types.List   `tfsdk:"extra_names"`
+	RecentWindow             types.Int64  `tfsdk:"recent_window"`
+	Seed                     types.Int64  `tfsdk:"seed"`
+	SingleCharacterSeparator types.Bool   `tfsdk:"single_character_separator"`
+}
+
+// loadCatalogue reads the ship names from the named file, which holds
+// either a JSON array of strings or one name per line.
+func loadCatalogue(filename string) ([]string, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	if content := bytes.TrimSpace(b); bytes.HasPrefix(content, []byte("[")) {
+		var names []string
+		if err := json.Unmarshal(content, &names); err != nil {
+			return nil, fmt.Errorf("invalid JSON array of ship names: %w", err)
+		}
+		return names, nil
+	}
+
+	return strings.Split(string(b), "\n"), nil
+}
+
+// catalogueFingerprint returns the hexadecimal SHA-256 hash of the given ship
+// names, which are those resources generate from as returned by the
+// generator's All, so that resources can tell when the catalogue has changed
+// but not when only its file's blank lines, line endings or duplicates have.
+func catalogueFingerprint(names []string) string {
+	h := sha256.New()
+	for _, name := range names {
+		h.Write([]byte(name))
+		h.Write([]byte{'\n'})
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// providerData is the provider configuration shared with resources and data
+// sources.
+type providerData struct {
+	catalogueFingerprint string
+	defaultSeparator     string
+	recent               *spaceships.RecentWindow
+	// registry holds the names handed out under ensure_unique, or is nil
+	// when it is unset. Each provider configuration has its own, so that an
+	// aliased configuration does not share names with another.
+	registry                 *spaceships.Registry
+	ships                    spaceships.Generator
+	singleCharacterSeparator bool
+	seeds                    *seedSequence
+}
+
+// checkSeparator reports whether separator is allowed by the provider's
+// single_character_separator, adding an error to diagnostics if not.
+func (d providerData) checkSeparator(separator string, diagnostics *diag.Diagnostics) bool {
+	if !d.singleCharacterSeparator || utf8.RuneCountInString(separator) == 1 {
+		return true
+	}
+
+	diagnostics.AddAttributeError(
+		path.Root("separator"),
+		"Invalid Separator",
+		fmt.Sprintf("The provider is configured with single_character_separator, so the separator must be "+
+			"exactly one character, got: %q.", separator),
+	)
+	return false
+}
+
+// newRand returns a source of randomness derived from the provider's seed,
+// or nil if the provider has no seed.
+func (d providerData) newRand() *rand.Rand {
+	if d.seeds == nil {
+		return nil
+	}
+
+	return d.seeds.newRand()
+}
+
+// seedSequence hands out consecutive seeds, starting from the provider's
+// seed, in the order resources ask for them.
+type seedSequence struct {
+	sync.Mutex
+	next int64
+}
+
+func (s *seedSequence) newRand() *rand.Rand {
+	s.Lock()
+	defer s.Unlock()
+
+	rnd := rand.New(rand.NewSource(s.next))
+	s.next++
+
+	return rnd
 }
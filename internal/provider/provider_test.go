@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+
+	"github.com/matthewbaggett/terraform-provider-fun-names/internal/spaceships"
+)
+
+func protoV5ProviderFactories() map[string]func() (tfprotov5.ProviderServer, error) {
+	return map[string]func() (tfprotov5.ProviderServer, error){
+		"fun-names": providerserver.NewProtocol5WithError(New()),
+	}
+}
+
+// protoV5ProviderFactoriesWithShips is like protoV5ProviderFactories, but the
+// provider generates the given ship names, as written in the books, in turn,
+// starting over after the last. Without any ship names, it generates empty
+// names, as if its catalogue were empty.
+func protoV5ProviderFactoriesWithShips(ships ...string) map[string]func() (tfprotov5.ProviderServer, error) {
+	generator := &fakeShipGenerator{ships: ships}
+
+	return map[string]func() (tfprotov5.ProviderServer, error){
+		"fun-names": providerserver.NewProtocol5WithError(&randomProvider{ships: generator}),
+	}
+}
+
+// fakeShipGenerator is a spaceships.Generator returning its ships in turn,
+// ignoring any filters and source of randomness. With no ships, it returns
+// the zero spaceships.Ship. As for the real generators, a ship is canonical
+// only if it is a ship of the books.
+type fakeShipGenerator struct {
+	mu    sync.Mutex
+	ships []string
+	next  int
+}
+
+var _ spaceships.Generator = (*fakeShipGenerator)(nil)
+
+func (g *fakeShipGenerator) Generate(separator string) string {
+	ship, _ := g.GenerateMatching(separator, nil)
+	return ship.Name
+}
+
+func (g *fakeShipGenerator) GenerateMatching(separator string, _ *rand.Rand, _ ...spaceships.Filter) (spaceships.Ship, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if len(g.ships) == 0 {
+		return spaceships.Ship{}, nil
+	}
+
+	ship := g.ships[g.next%len(g.ships)]
+	g.next++
+
+	words := strings.Fields(ship)
+
+	return spaceships.Ship{
+		Name:      strings.Join(words, separator),
+		Words:     words,
+		Canonical: spaceships.Canonical().Accepts(strings.Join(words, " ")),
+	}, nil
+}
+
+func (g *fakeShipGenerator) GenerateMatchingWeighted(separator string, rnd *rand.Rand, _ int, filters ...spaceships.Filter) (spaceships.Ship, error) {
+	return g.GenerateMatching(separator, rnd, filters...)
+}
+
+// CountMatching, Count and LengthRange describe the known ships, rather than
+// the fake's, so that they never stop the fake's ships from being generated.
+func (g *fakeShipGenerator) CountMatching(filters ...spaceships.Filter) int {
+	return spaceships.CountMatching(filters...)
+}
+
+func (g *fakeShipGenerator) Count() int {
+	return spaceships.Count()
+}
+
+// All returns the fake's own ships, rather than the known ships, so that
+// the catalogue fingerprint is that of the ships the fake generates.
+func (g *fakeShipGenerator) All() []string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	seen := make(map[string]struct{}, len(g.ships))
+	names := make([]string, 0, len(g.ships))
+	for _, ship := range g.ships {
+		name := strings.Join(strings.Fields(ship), " ")
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Matching returns the fake itself, which ignores filters.
+func (g *fakeShipGenerator) Matching(...spaceships.Filter) spaceships.Generator {
+	return g
+}
+
+// Find looks the name up among the fake's own ships, like a catalogue made
+// of them.
+func (g *fakeShipGenerator) Find(name, separator string) (spaceships.Ship, bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, ship := range g.ships {
+		words := strings.Fields(ship)
+		if strings.EqualFold(strings.Join(words, separator), name) {
+			return spaceships.Ship{
+				Name:      strings.Join(words, separator),
+				Words:     words,
+				Canonical: spaceships.Canonical().Accepts(strings.Join(words, " ")),
+			}, true
+		}
+	}
+
+	return spaceships.Ship{}, false
+}
+
+func (g *fakeShipGenerator) LengthRange(separator string) (int, int) {
+	return spaceships.LengthRange(separator)
+}
+
+func (g *fakeShipGenerator) GenerateAt(separator string, index int, _ ...spaceships.Filter) (spaceships.Ship, error) {
+	if len(g.ships) == 0 {
+		return spaceships.Ship{}, nil
+	}
+
+	ship := g.ships[index%len(g.ships)]
+	words := strings.Fields(ship)
+
+	return spaceships.Ship{
+		Name:      strings.Join(words, separator),
+		Words:     words,
+		Canonical: spaceships.Canonical().Accepts(strings.Join(words, " ")),
+	}, nil
+}
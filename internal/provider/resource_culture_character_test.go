@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccResourceCultureCharacter(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_character" "character" {
+							prefix    = "crew"
+							separator = "_"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("fun-names_culture_character.character", "id", regexp.MustCompile(`^crew_[^A-Z ]+_[^A-Z ]+$`)),
+					resource.TestMatchResourceAttr("fun-names_culture_character.character", "first_name", regexp.MustCompile(`^\S+$`)),
+					resource.TestMatchResourceAttr("fun-names_culture_character.character", "last_name", regexp.MustCompile(`^\S+$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureCharacter_Update(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "fun-names" {
+							seed = 3
+						}
+
+						resource "fun-names_culture_character" "character" {
+							keepers = {
+								"key" = "123"
+							}
+						}`,
+				Check: resource.TestMatchResourceAttr("fun-names_culture_character.character", "first_name", regexp.MustCompile(`^\S+$`)),
+			},
+			{
+				// A keeper with a null value is updated in place, keeping the
+				// name and its parts.
+				Config: `provider "fun-names" {
+							seed = 3
+						}
+
+						resource "fun-names_culture_character" "character" {
+							keepers = {
+								"key"   = "123"
+								"other" = null
+							}
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fun-names_culture_character.character", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("fun-names_culture_character.character", "id"),
+					resource.TestMatchResourceAttr("fun-names_culture_character.character", "first_name", regexp.MustCompile(`^\S+$`)),
+					resource.TestMatchResourceAttr("fun-names_culture_character.character", "last_name", regexp.MustCompile(`^\S+$`)),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccResourceCultureDrone(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_drone" "drone" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("fun-names_culture_drone.drone", "id", regexp.MustCompile(`^[^A-Z ]+$`)),
+					resource.TestCheckResourceAttr("fun-names_culture_drone.drone", "separator", "-"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureDrone_Prefix(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_drone" "drone" {
+							prefix    = "contact"
+							separator = "_"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("fun-names_culture_drone.drone", "id", regexp.MustCompile(`^contact_[^-]+$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureDrone_SeparatorInvalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_drone" "drone" {
+							separator = "x"
+						}`,
+				ExpectError: regexp.MustCompile(`must not contain letters or digits`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureDrone_SingleCharacterSeparator(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "fun-names" {
+							single_character_separator = true
+						}
+
+						resource "fun-names_culture_drone" "drone" {
+							separator = "__"
+						}`,
+				ExpectError: regexp.MustCompile(`must be exactly one\s+character`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureDrone_Keepers(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_drone" "drone" {
+							keepers = {
+								"key" = "123"
+							}
+						}`,
+				Check: resource.TestCheckResourceAttrSet("fun-names_culture_drone.drone", "id"),
+			},
+			{
+				Config: `resource "fun-names_culture_drone" "drone" {
+							keepers = {
+								"key" = "456"
+							}
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fun-names_culture_drone.drone", plancheck.ResourceActionReplace),
+					},
+				},
+			},
+		},
+	})
+}
@@ -5,47 +5,154 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"github.com/matthewbaggett/terraform-provider-fun-names/internal/spaceships"
+	"math/rand"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	listplanmodifiers "github.com/matthewbaggett/terraform-provider-fun-names/internal/planmodifiers/list"
 	mapplanmodifiers "github.com/matthewbaggett/terraform-provider-fun-names/internal/planmodifiers/map"
+	stringplanmodifiers "github.com/matthewbaggett/terraform-provider-fun-names/internal/planmodifiers/string"
+	stringvalidators "github.com/matthewbaggett/terraform-provider-fun-names/internal/validators/string"
 )
 
-var _ resource.Resource = (*cultureShipResource)(nil)
+var (
+	_ resource.Resource                     = (*cultureShipResource)(nil)
+	_ resource.ResourceWithConfigure        = (*cultureShipResource)(nil)
+	_ resource.ResourceWithConfigValidators = (*cultureShipResource)(nil)
+	_ resource.ResourceWithIdentity         = (*cultureShipResource)(nil)
+	_ resource.ResourceWithImportState      = (*cultureShipResource)(nil)
+	_ resource.ResourceWithModifyPlan       = (*cultureShipResource)(nil)
+	_ resource.ResourceWithUpgradeState     = (*cultureShipResource)(nil)
+	_ resource.ResourceWithValidateConfig   = (*cultureShipResource)(nil)
+)
 
 func NewCultureShipResource() resource.Resource {
-	return &cultureShipResource{}
+	return &cultureShipResource{
+		generator: spaceships.CatalogueGenerator{},
+	}
+}
+
+type cultureShipResource struct {
+	// generator generates the ship names. It is the provider's, once
+	// configured.
+	generator    spaceships.Generator
+	providerData providerData
 }
 
-type cultureShipResource struct{}
+func (r *cultureShipResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.generator = data.ships
+	r.providerData = *data
+}
 
 func (r *cultureShipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_culture_ship"
+	// An in-place separator change rewrites the id the identity is keyed on
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// cultureShipIdentityModel is the identity of a culture_ship, which is the
+// generated id.
+type cultureShipIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+// IdentitySchema keys the identity on the generated id, so that the resource
+// can be imported by identity and followed across moves.
+func (r *cultureShipResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The generated name, as in `id`.",
+			},
+		},
+	}
 }
 
 func (r *cultureShipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 2,
 		Description: "The resource `random_culture_ship` returns a name of a ship from the Culture Series by Ian M Banks\n" +
 			"\n" +
 			"It is much like the `random_pet` resource, but with a different name and a different set of default values.\n",
 		Attributes: map[string]schema.Attribute{
-			"keepers": schema.MapAttribute{
+			"keepers": schema.DynamicAttribute{
 				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
-					"resource. See [the main provider documentation](../index.html) for more information.",
+					"resource, or regenerate the name in place if `keepers_regenerate_in_place` is true. " +
+					"Values may be of any type, such as numbers and bools, and are compared with " +
+					"their type, so changing `1` to `\"1\"` also recreates the resource. " +
+					"See [the main provider documentation](../index.html) for more information.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Dynamic{
+					keepersRequiresReplaceModifier{dynamic: mapplanmodifiers.DynamicRequiresReplaceIfValuesNotNull()},
+				},
+			},
+			"keepers_list": schema.ListAttribute{
+				Description: "Arbitrary list of values that, when changed, will trigger recreation of " +
+					"resource, like `keepers`. Adding, removing or reordering elements also counts as a change.",
 				ElementType: types.StringType,
 				Optional:    true,
-				PlanModifiers: []planmodifier.Map{
-					mapplanmodifiers.RequiresReplaceIfValuesNotNull(),
+				PlanModifiers: []planmodifier.List{
+					keepersRequiresReplaceModifier{list: listplanmodifiers.RequiresReplaceIfValuesNotNull()},
 				},
 			},
+			"keepers_regenerate_in_place": schema.BoolAttribute{
+				Description: "When true, changing `keepers` or `keepers_list` regenerates the name in place, " +
+					"keeping the resource rather than destroying and recreating it. Every generated attribute, " +
+					"including `id`, changes in the update, so anything that needs the old name to be destroyed " +
+					"along with it should leave this unset. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			"prefix": schema.StringAttribute{
 				Description: "A string to prefix the name with.",
 				Optional:    true,
@@ -53,15 +160,327 @@ func (r *cultureShipResource) Schema(ctx context.Context, req resource.SchemaReq
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
-			"separator": schema.StringAttribute{
-				Description: "The character to separate words in the ship name. Defaults to \"-\"",
+			"suffix": schema.StringAttribute{
+				Description: "A string to suffix the name with.",
 				Optional:    true,
-				Computed:    true,
-				Default:     stringdefault.StaticString("-"),
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"prefix_separator": schema.StringAttribute{
+				Description: "The characters to join the prefix to the ship name with. Defaults to `separator`.",
+				Optional:    true,
+				Validators:  separatorValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"suffix_separator": schema.StringAttribute{
+				Description: "The characters to join the suffix to the ship name with. Defaults to `separator`.",
+				Optional:    true,
+				Validators:  separatorValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"min_length": schema.Int64Attribute{
+				Description: "The minimum number of characters in `id`, including the prefix and separators. " +
+					"Must not be negative.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"max_length": schema.Int64Attribute{
+				Description: "The maximum number of characters in `id`, including the prefix and separators. " +
+					"Must be at least 1.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"initials_max_length": schema.Int64Attribute{
+				Description: "The maximum number of characters in `initials`, which keeps the initials of the " +
+					"first words of longer names. Unlimited when unset.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name_count": schema.Int64Attribute{
+				Description: "The number of distinct ship names to generate into `names`. Must be at least 1. " +
+					"Defaults to 1. (`count` is reserved by Terraform.)",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"min_distance": schema.Int64Attribute{
+				Description: "The fewest single character insertions, deletions and substitutions (the Levenshtein " +
+					"distance) that must separate each of the `name_count` names in `names` from every other. " +
+					"Candidates closer than this to a name already chosen are drawn again, counting towards " +
+					"`max_retries`. Must be at least 1.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"min_words": schema.Int64Attribute{
+				Description: "The minimum number of words in the generated ship name, not counting the prefix and " +
+					"suffix. Must not be negative.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"max_words": schema.Int64Attribute{
+				Description: "The maximum number of words in the generated ship name, not counting the prefix and " +
+					"suffix. Must be at least 1.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"regex": schema.StringAttribute{
+				Description: "Only generate names of ships whose name, as written in the books, matches this " +
+					"regular expression. For example `Gravitas` only generates ships with \"Gravitas\" in their " +
+					"name. Uses the [RE2 syntax](https://github.com/google/re2/wiki/Syntax).",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidators.IsRegexp(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"canonical_only": schema.BoolAttribute{
+				Description: "When true, only generate names of ships attested in the books, never names from " +
+					"any other source. Every built-in ship name is attested, so this only guards against names " +
+					"from elsewhere. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"replace_on_catalogue_change": schema.BoolAttribute{
+				Description: "When true, the resource is replaced, drawing a new name, whenever the ship names " +
+					"the provider generates from change, such as when the file at `catalogue_path` is edited. " +
+					"Defaults to `false`, which keeps the name however the catalogue changes.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"dedupe_prefix": schema.BoolAttribute{
+				Description: "When true, the prefix is not prepended to a generated ship name that already begins " +
+					"with it as a whole word, compared case-insensitively, so that a prefix of `gsv` never gives " +
+					"`gsv-gsv-...`. With an empty separator there are no word boundaries, so any ship name " +
+					"starting with the prefix counts. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"dns_safe": schema.BoolAttribute{
+				Description: "When true, `id` is a valid DNS label as defined by RFC 1123, as Kubernetes requires " +
+					"of many names: the composed name is lowercased and every run of characters other than ASCII " +
+					"letters and digits, including the separators, becomes a single hyphen, with none at either " +
+					"end. Ship names that would give a label longer than 63 characters are drawn again rather " +
+					"than truncated. `name` keeps the configured separator and case. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"exclude": schema.ListAttribute{
+				Description: "Ship names, as written in the books, that must never be generated. Names are " +
+					"compared case-insensitively against the ship name alone, without the prefix or suffix.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"include_only": schema.ListAttribute{
+				Description: "Only generate ship names from this list, as written in the books. Names are " +
+					"compared case-insensitively, and any that are not known ship names are ignored with a warning.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"index": schema.Int64Attribute{
+				Description: "When set, the ship is chosen by position rather than at random: the names matching " +
+					"the other constraints are sorted, and the one at `index`, wrapping around past the end, is " +
+					"used. Further names for `name_count`, and retries for `min_length` and `max_length`, take " +
+					"the names that follow, so using `count.index` gives each instance a stable, distinct name. " +
+					"`seed` and `favor_iconic` have no effect when it is set. Must be at least 0. Changing the " +
+					"index forces a new resource to be created.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"sort": schema.StringAttribute{
+				Description: "The order of `names`. One of `none`, keeping the order the names were generated " +
+					"in, `asc` or `desc`, sorting them alphabetically, or `length`, sorting them from shortest to " +
+					"longest and alphabetically among names of the same length. A sorted list keeps the keys of " +
+					"`for_each` over `names` stable. `id` is always the first name generated, whatever the order. " +
+					"Defaults to `none`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(sortNone),
+				Validators: []validator.String{
+					stringvalidator.OneOf(sortNone, sortAsc, sortDesc, sortLength),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"seed": schema.Int64Attribute{
+				Description: "A seed that makes the generated ship name deterministic: the same seed always " +
+					"produces the same ship. When unset, the ship is chosen at random. Changing the seed " +
+					"forces a new resource to be created.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"separator": schema.StringAttribute{
+				Description: "The characters to separate words in the ship name, and to join the prefix and " +
+					"suffix to it. May be more than one character long, but may not contain letters or digits. " +
+					"Defaults to the provider's `default_separator`, or \"-\" if that is not set either.",
+				Optional:   true,
+				Computed:   true,
+				Validators: separatorValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifiers.RequiresReplaceIfEffectiveValueChanges(
+						"-",
+						separatorRequiresReplace,
+						"Changing the separator replaces the resource, unless in_place_separator is true.",
+						"Changing the separator replaces the resource, unless `in_place_separator` is true.",
+					),
+				},
+			},
+			"in_place_separator": schema.BoolAttribute{
+				Description: "When true, changing `separator` keeps the generated ship and only rejoins its words " +
+					"with the new separator, updating `id`, `name` and `names` in place rather than replacing the " +
+					"resource. A name generated with an empty separator cannot be split back into its words, so " +
+					"it is still replaced. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"case": schema.StringAttribute{
+				Description: "The capitalisation applied to the generated ship name. One of `lower`, `upper`, `title` " +
+					"or `original`, where `original` keeps the casing used in the books. `title` capitalises each " +
+					"word, except for articles, coordinating conjunctions and short prepositions such as \"of\" " +
+					"and \"the\" that are neither the first nor the last word. Defaults to `lower`. " +
+					"Unlike `random_string`, there are no `lower` and `upper` booleans: the modes are mutually " +
+					"exclusive, so a single attribute cannot be misconfigured with both or neither set.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(caseLower),
+				Validators: []validator.String{
+					stringvalidator.OneOf(caseLower, caseUpper, caseTitle, caseOriginal),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"normalize_case": schema.BoolAttribute{
+				Description: "When true, `case` is also applied to the prefix and suffix, so that the whole of " +
+					"`id` is cased consistently. For example, `prefix = \"PROD\"` gives an `id` such as " +
+					"`prod-sleeper-service`. When false, the prefix and suffix are used verbatim. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"favor_iconic": schema.BoolAttribute{
+				Description: "When true, the best known ships of the series, such as \"Of Course I Still Love You\", " +
+					"are drawn `iconic_weight` times more often than any other. Defaults to `false`, drawing every " +
+					"ship equally often.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"iconic_weight": schema.Int64Attribute{
+				Description: fmt.Sprintf("How many times more often each iconic ship is drawn than any other when "+
+					"`favor_iconic` is true. Must be at least 1. Defaults to %d.", spaceships.DefaultIconicWeight),
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(spaceships.DefaultIconicWeight),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: fmt.Sprintf("The most ship names drawn while looking for one that satisfies "+
+					"`min_length` and `max_length`, and the most duplicates drawn while looking for `name_count` "+
+					"distinct names. Must be at least 1. Defaults to %d.", defaultMaxRetries),
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(defaultMaxRetries),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"class": schema.StringAttribute{
+				Description: "Only generate names of ships attested for this class, given by its abbreviation " +
+					"(for example `GSV` for a General Systems Vehicle or `GCU` for a General Contact Unit). " +
+					"When unset, this is the class of the generated ship, or null if its class is not known.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(spaceships.Classes()...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifiers.UseStateForUnknownIncludingNull(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"id": schema.StringAttribute{
 				Description: "The random ship name.",
 				Computed:    true,
@@ -69,70 +488,1368 @@ func (r *cultureShipResource) Schema(ctx context.Context, req resource.SchemaReq
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"name": schema.StringAttribute{
+				Description: "The generated ship name, without the prefix.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"names": schema.ListAttribute{
+				Description: "The `name_count` distinct random ship names, each composed like `id`, ordered " +
+					"as `sort` says. With the default `sort` of `none`, the first is always the same as `id`.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"word_count": schema.Int64Attribute{
+				Description: "The number of words in `name`, which is the length of `words`, so that a " +
+					"hyphenated word counts once whatever the separator. The prefix and suffix are not counted.",
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"length": schema.Int64Attribute{
+				Description: "The number of characters in `id`, including the prefix and separators.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"words": schema.ListAttribute{
+				Description: "The words of `name`, in order and with `case` applied, before they are joined by " +
+					"the separator.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"initials": schema.StringAttribute{
+				Description: "The first letter or digit of each word of the ship name, as written in the books, " +
+					"in upper case, for example `OCISLY` for Of Course I Still Love You. The prefix and suffix " +
+					"are not included, and words without letters or digits are skipped. Truncated to " +
+					"`initials_max_length` if set.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"catalogue_fingerprint": schema.StringAttribute{
+				Description: "The SHA-256 hash of the ship names the provider generated the name from, " +
+					"including those of `catalogue_path` and `extra_names`. It keeps the value for the " +
+					"catalogue the name was drawn from, unless `replace_on_catalogue_change` is true.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reversed": schema.StringAttribute{
+				Description: "The words of `name` in reverse order, joined by the separator, for example " +
+					"`you-love-still-i-course-of`. Null when the name is a single word.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"variants": schema.MapAttribute{
+				Description: "The id with the words of the ship name, and the prefix and suffix, joined by other " +
+					"common separators instead of the configured ones, keyed by `dash` (\"-\"), `space` (\" \") " +
+					"and `underscore` (\"_\"). `case`, `normalize_case` and `dedupe_prefix` apply as they do to " +
+					"`id`; `dns_safe` does not.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hash": schema.StringAttribute{
+				Description: "The first 8 hexadecimal characters of the SHA-256 hash of `id`, for use as a short, " +
+					"stable identifier derived from the name.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"phonetic": schema.StringAttribute{
+				Description: "`id` spelt out in the NATO phonetic alphabet, one space-separated word per character, " +
+					"for example `Golf Sierra Victor dash Alpha ...`. Digits are given as words and punctuation " +
+					"by its name, such as `dash` or `underscore`.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_canonical": schema.BoolAttribute{
+				Description: "Whether the generated ship name is attested in the books, as opposed to having been " +
+					"made up. Always true when `canonical_only` is set. An imported name is only canonical if it " +
+					"contains a known ship.",
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source": schema.StringAttribute{
+				Description: "The title of the novel the generated ship appears in, or null if it is not known.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifiers.UseStateForUnknownIncludingNull(),
+				},
+			},
+			"decoration": schema.StringAttribute{
+				Description: "How `decorated` dresses up the generated ship name. One of `none`, giving `name` " +
+					"as it is, `brackets`, wrapping it in square brackets, `quotes`, wrapping it in double quotes, " +
+					"or `gsv_prefix`, giving the ship as written in the books after its class abbreviation in " +
+					"the style of the novels, for example `GSV Sleeper Service`, or without one if its class is " +
+					"not known. Defaults to `none`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(decorationNone),
+				Validators: []validator.String{
+					stringvalidator.OneOf(decorationNone, decorationBrackets, decorationQuotes, decorationGSVPrefix),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"decorated": schema.StringAttribute{
+				Description: "`name` decorated as `decoration` says, for example `[sleeper-service]` for " +
+					"`brackets`.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"markdown": schema.StringAttribute{
+				Description: "`name` as a Markdown link to its entry under `markdown_base_url`, for example " +
+					"`[sleeper-service](https://theculture.fandom.com/wiki/sleeper-service)`. The name is " +
+					"URL-encoded in the link target.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"metadata_json": schema.StringAttribute{
+				Description: "A JSON object describing the generated ship, with the keys `name`, `class`, " +
+					"`source`, `word_count` and `length` holding the values of the attributes of the same " +
+					"names. `class` and `source` are null when they are not known. More keys may be added " +
+					"in future, so consumers should ignore any they do not recognise.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"markdown_base_url": schema.StringAttribute{
+				Description: "The URL that `markdown` links the name under. Defaults to the Culture wiki, " +
+					"`" + defaultMarkdownBaseURL + "`. Changing it updates `markdown` in place.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(defaultMarkdownBaseURL),
+			},
+			"slug": schema.StringAttribute{
+				Description: "A URL-safe form of `name`: lowercased, with every run of characters other than " +
+					"letters and digits replaced by a single hyphen and no leading or trailing hyphens. " +
+					"It does not depend on `separator` or `case`, and excludes the prefix and suffix.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
 
-func (r *cultureShipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	// This is necessary to ensure each call to petname is properly randomised:
-	// the library uses `rand.Intn()` and does NOT seed `rand.Seed()` by default,
-	// so this call takes care of that.
-	spaceships.NonDeterministicMode()
+func (r *cultureShipResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	defaultSeparator := r.providerData.defaultSeparator
+	if defaultSeparator == "" {
+		// The provider has not been configured yet
+		defaultSeparator = "-"
+	}
 
-	var plan cultureShipModelV0
+	return []resource.ConfigValidator{
+		prefixSeparatorValidator{defaultSeparator: defaultSeparator},
+		boundsValidator{min: "min_length", max: "max_length", unit: "characters"},
+		boundsValidator{min: "min_words", max: "max_words", unit: "words"},
+		includeExcludeValidator{},
+	}
+}
 
-	diags := req.Plan.Get(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
+// ValidateConfig warns about include_only entries that are not known ship
+// names, as they can never be generated.
+func (r *cultureShipResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var includeOnly types.List
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("include_only"), &includeOnly)...)
+	if resp.Diagnostics.HasError() || includeOnly.IsNull() || includeOnly.IsUnknown() {
+		return
+	}
+
+	var include []types.String
+
+	resp.Diagnostics.Append(includeOnly.ElementsAs(ctx, &include, false)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	separator := plan.Separator.ValueString()
-	prefix := plan.Prefix.ValueString()
+	known := spaceships.IncludingOnly(spaceships.All())
 
-	ship := strings.ToLower(spaceships.Generate(separator))
+	var unknown []string
+	for _, name := range include {
+		if !name.IsUnknown() && !name.IsNull() && !known.Accepts(name.ValueString()) {
+			unknown = append(unknown, strconv.Quote(name.ValueString()))
+		}
+	}
 
-	pn := cultureShipModelV0{
-		Keepers:   plan.Keepers,
-		Separator: types.StringValue(separator),
+	if len(unknown) > 0 {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("include_only"),
+			"Unknown Ship Names",
+			fmt.Sprintf("The following names are not known ship names and will never be generated: %s.", strings.Join(unknown, ", ")),
+		)
 	}
+}
 
-	if prefix != "" {
-		ship = fmt.Sprintf("%s%s%s", prefix, separator, ship)
-		pn.Prefix = types.StringValue(prefix)
-	} else {
-		pn.Prefix = types.StringNull()
+// ModifyPlan fills in the provider's default separator when none is
+// configured. This cannot be a schema default, as those cannot read the
+// provider configuration. Existing resources keep the separator they were
+// created with, even if the provider default later changes.
+//
+// When in_place_separator is set and the separator changes, ModifyPlan also
+// plans the rejoined id, name and names, which Update then stores as planned.
+// It likewise keeps markdown and metadata_json up to date with the attributes
+// they are derived from, and replaces the resource when the catalogue changes
+// if replace_on_catalogue_change is set.
+func (r *cultureShipResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
 	}
 
-	pn.ID = types.StringValue(ship)
+	unknown := planDefaultSeparator(ctx, req, resp, r.providerData.defaultSeparator)
+	if resp.Diagnostics.HasError() || unknown && !req.State.Raw.IsNull() {
+		return
+	}
 
-	diags = resp.State.Set(ctx, pn)
-	resp.Diagnostics.Append(diags...)
+	// Nothing more to do when the resource is being created, beyond filling
+	// in what the configuration alone decides.
+	if req.State.Raw.IsNull() {
+		r.modifyCreatePlan(ctx, resp)
+		return
+	}
+
+	var plan, state cultureShipModelV2
+
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-}
 
-// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
-func (r *cultureShipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-}
+	if plan.InPlaceSeparator.ValueBool() && !plan.Separator.Equal(state.Separator) {
+		if !r.providerData.checkSeparator(plan.Separator.ValueString(), &resp.Diagnostics) {
+			return
+		}
 
-// Update ensures the plan value is copied to the state to complete the update.
-func (r *cultureShipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var model cultureShipModelV0
+		var ids []string
 
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+		resp.Diagnostics.Append(state.Names.ElementsAs(ctx, &ids, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 
-	if resp.Diagnostics.HasError() {
-		return
+		// The words of the name are the same whatever joins them, and
+		// splitting the name on the old separator would also split any word
+		// containing it, such as "character-forming" when it was "-"
+		var words []string
+		resp.Diagnostics.Append(state.Words.ElementsAs(ctx, &words, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		from, to := newIDComposer(state), newIDComposer(plan)
+		mode := plan.Case.ValueString()
+		ship := applyCase(strings.Join(words, to.separator), to.separator, mode)
+
+		// Separators never survive in a DNS label, so only name changes
+		if !plan.DNSSafe.ValueBool() {
+			for i, id := range ids {
+				ids[i] = to.compose(reseparateShip(r.generator, from.ship(id), from.separator, to.separator, mode))
+			}
+			sortNames(ids, plan.Sort.ValueString())
+
+			names, diags := types.ListValueFrom(ctx, types.StringType, ids)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			id := to.compose(ship)
+
+			plan.Hash = types.StringValue(idHash(id))
+			plan.ID = types.StringValue(id)
+			plan.Length = types.Int64Value(int64(len(id)))
+			plan.Names = names
+			plan.Phonetic = types.StringValue(phonetic(id))
+		}
+
+		plan.Name = types.StringValue(ship)
+		plan.Reversed = reversedName(words, to.separator)
+		// The ship as written in the books is the same whatever joins it
+		if plan.Decoration.ValueString() != decorationGSVPrefix {
+			plan.Decorated = types.StringValue(decorate(ship, "", "", plan.Decoration.ValueString()))
+		}
+		plan.WordCount = types.Int64Value(int64(len(words)))
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if plan.KeepersRegenerateInPlace.ValueBool() {
+		changed, diags := keepersChanged(ctx, req)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Update regenerates the name, so everything derived from it is
+		// only known once it has
+		if changed {
+			plan.Class = types.StringUnknown()
+			plan.Decorated = types.StringUnknown()
+			plan.Hash = types.StringUnknown()
+			plan.ID = types.StringUnknown()
+			plan.Initials = types.StringUnknown()
+			plan.IsCanonical = types.BoolUnknown()
+			plan.Length = types.Int64Unknown()
+			plan.Markdown = types.StringUnknown()
+			plan.MetadataJSON = types.StringUnknown()
+			plan.Name = types.StringUnknown()
+			plan.Names = types.ListUnknown(types.StringType)
+			plan.Phonetic = types.StringUnknown()
+			plan.Reversed = types.StringUnknown()
+			plan.Slug = types.StringUnknown()
+			plan.Source = types.StringUnknown()
+			plan.Variants = types.MapUnknown(types.StringType)
+			plan.WordCount = types.Int64Unknown()
+			plan.Words = types.ListUnknown(types.StringType)
+		}
+	}
+
+	// Resources created before catalogue_fingerprint was added, or upgraded
+	// from before it, have no value to keep, so take the current catalogue's.
+	fingerprint := types.StringValue(r.providerData.catalogueFingerprint)
+	if plan.CatalogueFingerprint.IsUnknown() {
+		plan.CatalogueFingerprint = fingerprint
+	}
+
+	if plan.ReplaceOnCatalogueChange.ValueBool() && !state.CatalogueFingerprint.IsNull() &&
+		!state.CatalogueFingerprint.Equal(fingerprint) {
+		plan.CatalogueFingerprint = fingerprint
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("catalogue_fingerprint"))
+	}
+
+	// Resources created before is_canonical was added have no value to keep,
+	// so work it out from the name rather than leave it unknown on update.
+	if plan.IsCanonical.IsUnknown() && !plan.Name.IsUnknown() {
+		plan.IsCanonical = types.BoolValue(spaceships.Contains(plan.Name.ValueString()))
+	}
+
+	if !plan.Name.IsUnknown() && !plan.MarkdownBaseURL.IsUnknown() {
+		plan.Markdown = types.StringValue(markdownLink(plan.Name.ValueString(), plan.MarkdownBaseURL.ValueString()))
+	}
+
+	// Resources created before decorated was added were not decorated, so
+	// their name is taken as it is rather than left unknown on update.
+	if plan.Decorated.IsUnknown() && !plan.Name.IsUnknown() && plan.Decoration.ValueString() == decorationNone {
+		plan.Decorated = plan.Name
+	}
+
+	// Resources created before initials was added have no value to keep, so
+	// work it out from the name rather than leave it unknown on update.
+	if plan.Initials.IsUnknown() && !plan.Name.IsUnknown() {
+		plan.Initials = types.StringValue(initials(nameWords(plan.Name.ValueString(), plan.Separator.ValueString()),
+			plan.InitialsMaxLength.ValueInt64()))
+	}
+
+	// Resources created before reversed was added have no value to keep, so
+	// work it out from the name rather than leave it unknown on update.
+	if plan.Reversed.IsUnknown() && !plan.Words.IsUnknown() {
+		var words []string
+		resp.Diagnostics.Append(plan.Words.ElementsAs(ctx, &words, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		plan.Reversed = reversedName(words, plan.Separator.ValueString())
+	}
+
+	// Resources created before variants was added have no value to keep, so
+	// work it out from the words rather than leave it unknown on update.
+	if plan.Variants.IsUnknown() && !plan.Words.IsUnknown() {
+		var words []string
+		resp.Diagnostics.Append(plan.Words.ElementsAs(ctx, &words, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		variants, diags := types.MapValueFrom(ctx, types.StringType, newIDComposer(plan).variants(words))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.Variants = variants
+	}
+
+	if !plan.ID.IsUnknown() && !plan.Name.IsUnknown() {
+		plan.MetadataJSON = types.StringValue(metadataJSON(plan))
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
 }
 
-// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
-// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
-func (r *cultureShipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+// planDefaultSeparator sets the planned separator to the provider's
+// default_separator when it is unknown because the configuration leaves it
+// unset, as it is on create. It reports whether the planned separator was
+// unknown, including when the configuration sets it to a value only known
+// after apply, which is left unknown.
+func planDefaultSeparator(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, defaultSeparator string) bool {
+	var separator types.String
+
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("separator"), &separator)...)
+	if resp.Diagnostics.HasError() || !separator.IsUnknown() {
+		return false
+	}
+
+	var config types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("separator"), &config)...)
+	if resp.Diagnostics.HasError() || config.IsUnknown() {
+		return true
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("separator"), defaultSeparator)...)
+	return true
+}
+
+// modifyCreatePlan sets the computed attributes that are the same whichever
+// name is drawn, so that the plan shows them rather than leaving them known
+// after apply. The name, and everything derived from it, stays unknown so
+// that it is still drawn at random by Create.
+func (r *cultureShipResource) modifyCreatePlan(ctx context.Context, resp *resource.ModifyPlanResponse) {
+	var plan cultureShipModelV2
+
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Separator.IsUnknown() && !r.providerData.checkSeparator(plan.Separator.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	if plan.CatalogueFingerprint.IsUnknown() {
+		plan.CatalogueFingerprint = types.StringValue(r.providerData.catalogueFingerprint)
+	}
+
+	// name_count is checked against the matching ships as soon as every
+	// input deciding them is known, rather than waiting for apply
+	if !plan.NameCount.IsUnknown() && nameFiltersKnown(plan) {
+		filters, ok := nameFilters(ctx, plan, &resp.Diagnostics)
+		if !ok || !checkPool(int(plan.NameCount.ValueInt64()), r.generator.CountMatching(filters...), &resp.Diagnostics) {
+			return
+		}
+	}
+
+	if plan.IsCanonical.IsUnknown() && plan.CanonicalOnly.ValueBool() {
+		plan.IsCanonical = types.BoolValue(true)
+	}
+
+	// Every name is rejected unless it is exactly min_length characters long
+	// when max_length is the same.
+	if plan.Length.IsUnknown() && !plan.MinLength.IsNull() && !plan.MinLength.IsUnknown() &&
+		plan.MinLength.Equal(plan.MaxLength) {
+		plan.Length = plan.MinLength
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+// nameFilters returns the filters selecting the ships plan may draw from,
+// reporting false, with the reason added to diagnostics, if exclude or
+// include_only cannot be read.
+func nameFilters(ctx context.Context, plan cultureShipModelV2, diagnostics *diag.Diagnostics) ([]spaceships.Filter, bool) {
+	var filters []spaceships.Filter
+	if class := plan.Class.ValueString(); class != "" {
+		filters = append(filters, spaceships.InClass(class))
+	}
+	if !plan.MinWords.IsNull() || !plan.MaxWords.IsNull() {
+		filters = append(filters, spaceships.WithWordBounds(int(plan.MinWords.ValueInt64()), int(plan.MaxWords.ValueInt64())))
+	}
+	if plan.CanonicalOnly.ValueBool() {
+		filters = append(filters, spaceships.Canonical())
+	}
+	if !plan.Exclude.IsNull() {
+		var exclude []string
+
+		diagnostics.Append(plan.Exclude.ElementsAs(ctx, &exclude, false)...)
+		if diagnostics.HasError() {
+			return nil, false
+		}
+
+		filters = append(filters, spaceships.Excluding(exclude))
+	}
+	if !plan.IncludeOnly.IsNull() {
+		var include []string
+
+		diagnostics.Append(plan.IncludeOnly.ElementsAs(ctx, &include, false)...)
+		if diagnostics.HasError() {
+			return nil, false
+		}
+
+		filters = append(filters, spaceships.IncludingOnly(include))
+	}
+	if !plan.Regex.IsNull() {
+		// The validator has already checked that the pattern compiles.
+		filters = append(filters, spaceships.MatchingRegexp(regexp.MustCompile(plan.Regex.ValueString())))
+	}
+
+	return filters, true
+}
+
+// nameFiltersKnown reports whether every attribute deciding the filters of
+// nameFilters is known, so that they can be built at plan time.
+func nameFiltersKnown(plan cultureShipModelV2) bool {
+	for _, value := range []attr.Value{
+		plan.CanonicalOnly, plan.Class, plan.Exclude, plan.IncludeOnly, plan.MaxWords, plan.MinWords, plan.Regex,
+	} {
+		if value.IsUnknown() {
+			return false
+		}
+	}
+
+	for _, list := range []types.List{plan.Exclude, plan.IncludeOnly} {
+		for _, element := range list.Elements() {
+			if element.IsUnknown() {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// checkPool reports whether nameCount distinct names can be drawn from the
+// pool of matching ships, adding an error to diagnostics if no ship matches
+// or too few do.
+func checkPool(nameCount, pool int, diagnostics *diag.Diagnostics) bool {
+	if pool == 0 {
+		diagnostics.AddError(
+			"No Matching Ship Names",
+			"None of the known ship names satisfy the configured class, min_words, max_words, regex, exclude, "+
+				"include_only and canonical_only. "+
+				"Relax these constraints and retry.",
+		)
+		return false
+	}
+
+	if nameCount <= pool {
+		return true
+	}
+
+	diagnostics.AddAttributeError(
+		path.Root("name_count"),
+		"Too Many Names Requested",
+		fmt.Sprintf("Unable to generate %d distinct ship names: only %d of the known ship names satisfy the "+
+			"configured constraints.", nameCount, pool),
+	)
+	return false
+}
+
+// generate draws a ship name for plan, returning the model to store in the
+// state with every generated attribute set. It reports false, with the
+// reason added to diagnostics, if no name could be generated.
+func (r *cultureShipResource) generate(ctx context.Context, plan cultureShipModelV2, diagnostics *diag.Diagnostics) (_ cultureShipModelV2, ok bool) {
+	separator := plan.Separator.ValueString()
+	prefix := plan.Prefix.ValueString()
+	suffix := plan.Suffix.ValueString()
+
+	// idPrefix and idSuffix are the prefix and suffix as they appear in id.
+	idPrefix, idSuffix := prefix, suffix
+	if plan.NormalizeCase.ValueBool() {
+		idPrefix = applyCase(prefix, separator, plan.Case.ValueString())
+		idSuffix = applyCase(suffix, separator, plan.Case.ValueString())
+	}
+
+	prefixSeparator := separator
+	if !plan.PrefixSeparator.IsNull() {
+		prefixSeparator = plan.PrefixSeparator.ValueString()
+	}
+
+	suffixSeparator := separator
+	if !plan.SuffixSeparator.IsNull() {
+		suffixSeparator = plan.SuffixSeparator.ValueString()
+	}
+
+	if !r.providerData.checkSeparator(separator, diagnostics) {
+		return cultureShipModelV2{}, false
+	}
+
+	minLength, maxLength := composedLengthRange(r.generator, idPrefix, prefixSeparator, idSuffix, suffixSeparator, separator)
+	if !plan.MinLength.IsNull() && plan.MinLength.ValueInt64() > int64(maxLength) {
+		diagnostics.AddAttributeError(
+			path.Root("min_length"),
+			"Unsatisfiable Length Constraint",
+			fmt.Sprintf("No ship name is long enough to satisfy min_length = %d: the longest possible name, "+
+				"including prefix, suffix and separators, is %d characters.", plan.MinLength.ValueInt64(), maxLength),
+		)
+	}
+	if !plan.MaxLength.IsNull() && plan.MaxLength.ValueInt64() < int64(minLength) {
+		diagnostics.AddAttributeError(
+			path.Root("max_length"),
+			"Unsatisfiable Length Constraint",
+			fmt.Sprintf("No ship name is short enough to satisfy max_length = %d: the shortest possible name, "+
+				"including prefix, suffix and separators, is %d characters.", plan.MaxLength.ValueInt64(), minLength),
+		)
+	}
+	if plan.DNSSafe.ValueBool() && plan.MinLength.ValueInt64() > dnsLabelMaxLength {
+		diagnostics.AddAttributeError(
+			path.Root("min_length"),
+			"Unsatisfiable Length Constraint",
+			fmt.Sprintf("No ship name can satisfy both min_length = %d and dns_safe: DNS labels are at most %d "+
+				"characters long.", plan.MinLength.ValueInt64(), dnsLabelMaxLength),
+		)
+	}
+	if diagnostics.HasError() {
+		return cultureShipModelV2{}, false
+	}
+
+	class := plan.Class.ValueString()
+
+	var rnd *rand.Rand
+	if !plan.Seed.IsNull() {
+		rnd = rand.New(rand.NewSource(plan.Seed.ValueInt64()))
+	} else {
+		rnd = r.providerData.newRand()
+	}
+
+	filters, ok := nameFilters(ctx, plan, diagnostics)
+	if !ok {
+		return cultureShipModelV2{}, false
+	}
+
+	pool := r.generator.CountMatching(filters...)
+
+	// active names the configured filters for the debug summary below, which
+	// is the first thing to check when every apply seems to pick the same name.
+	var active []string
+	for name, set := range map[string]bool{
+		"canonical_only": plan.CanonicalOnly.ValueBool(),
+		"class":          class != "",
+		"exclude":        !plan.Exclude.IsNull(),
+		"include_only":   !plan.IncludeOnly.IsNull(),
+		"index":          !plan.Index.IsNull(),
+		"max_length":     !plan.MaxLength.IsNull(),
+		"max_words":      !plan.MaxWords.IsNull(),
+		"min_distance":   !plan.MinDistance.IsNull(),
+		"min_length":     !plan.MinLength.IsNull(),
+		"min_words":      !plan.MinWords.IsNull(),
+		"regex":          !plan.Regex.IsNull(),
+		"seed":           !plan.Seed.IsNull(),
+	} {
+		if set {
+			active = append(active, name)
+		}
+	}
+	sort.Strings(active)
+
+	tflog.Debug(ctx, "Effective culture ship configuration", map[string]interface{}{
+		"case":         plan.Case.ValueString(),
+		"favor_iconic": plan.FavorIconic.ValueBool(),
+		"filters":      active,
+		"name_count":   plan.NameCount.ValueInt64(),
+		"pool_size":    pool,
+		"separator":    separator,
+	})
+	if !checkPool(int(plan.NameCount.ValueInt64()), pool, diagnostics) {
+		return cultureShipModelV2{}, false
+	}
+
+	if pool < smallPoolSize {
+		diagnostics.AddWarning(
+			"Few Matching Ship Names",
+			fmt.Sprintf("Only %d of the known ship names satisfy the configured class, min_words, max_words, "+
+				"regex, exclude, include_only and canonical_only, so generated names are likely to repeat. "+
+				"Relax these constraints for more variety.", pool),
+		)
+	}
+
+	// index is the position of the next ship to take when index is set.
+	index := int(plan.Index.ValueInt64())
+
+	// The ships are matched once, rather than on every draw, so that a large
+	// name_count does not filter the whole catalogue for each name
+	candidates := r.generator.Matching(filters...)
+
+	generate := func() (spaceships.Ship, error) {
+		if !plan.Index.IsNull() {
+			index++
+			return candidates.GenerateAt(separator, index-1)
+		}
+		if plan.FavorIconic.ValueBool() {
+			return candidates.GenerateMatchingWeighted(separator, rnd, int(plan.IconicWeight.ValueInt64()))
+		}
+		return candidates.GenerateMatching(separator, rnd)
+	}
+
+	maxRetries := int(plan.MaxRetries.ValueInt64())
+
+	// attempts counts every ship name drawn, across all of name_count, for
+	// the debug log of slow generation under tight constraints.
+	attempts := 0
+
+	// generateID draws ship names until one satisfies the configured
+	// constraints, returning the ship and its composed id.
+	generateID := func() (spaceships.Ship, string, string, bool) {
+		for attempt := 0; attempt < maxRetries; attempt++ {
+			attempts++
+
+			generated, err := generate()
+			if err != nil {
+				diagnostics.AddError(
+					"Ship Name Generation Error",
+					fmt.Sprintf("Unable to generate a ship name: %s.", err),
+				)
+				return spaceships.Ship{}, "", "", false
+			}
+
+			if generated.Name == "" {
+				diagnostics.AddError(
+					"Ship Name Generation Error",
+					"The ship name generator returned an empty name, so no id can be set. This means the "+
+						"catalogue of known ship names is empty or failed to load. "+
+						"Please report this issue to the provider developers.",
+				)
+				return spaceships.Ship{}, "", "", false
+			}
+
+			ship := applyCase(generated.Name, separator, plan.Case.ValueString())
+
+			shipPrefix := idPrefix
+			if plan.DedupePrefix.ValueBool() && startsWithWord(ship, idPrefix, separator) {
+				shipPrefix = ""
+			}
+
+			id := composeIDWithSeparators(shipPrefix, prefixSeparator, ship, idSuffix, suffixSeparator)
+
+			if plan.DNSSafe.ValueBool() {
+				id = dnsLabel(id)
+				if id == "" || len(id) > dnsLabelMaxLength {
+					continue
+				}
+			}
+
+			if !plan.MinLength.IsNull() && int64(len(id)) < plan.MinLength.ValueInt64() {
+				continue
+			}
+			if !plan.MaxLength.IsNull() && int64(len(id)) > plan.MaxLength.ValueInt64() {
+				continue
+			}
+
+			return generated, ship, id, true
+		}
+
+		diagnostics.AddError(
+			"Ship Name Generation Error",
+			fmt.Sprintf("No ship name satisfying the configured constraints was found after %d attempts. "+
+				"Relax min_length or max_length, or raise max_retries, and retry.", maxRetries),
+		)
+		return spaceships.Ship{}, "", "", false
+	}
+
+	nameCount := int(plan.NameCount.ValueInt64())
+
+	constrained := !plan.Regex.IsNull() || !plan.MinLength.IsNull() || !plan.MaxLength.IsNull() ||
+		!plan.MinWords.IsNull() || !plan.MaxWords.IsNull()
+	if constrained {
+		start := time.Now()
+		defer func() {
+			tflog.Debug(ctx, "Generated culture ship names under constraints", map[string]interface{}{
+				"attempts":   attempts,
+				"elapsed":    time.Since(start).String(),
+				"name_count": nameCount,
+			})
+		}()
+	}
+
+	var generated spaceships.Ship
+	var ship, id string
+
+	// ids is allocated once at its final size and duplicates are found in
+	// seen, so that a large name_count costs time in proportion to it
+	ids := make([]string, 0, nameCount)
+	seen := make(map[string]struct{}, nameCount)
+	// A batch that fails part way is never handed out, so any names it
+	// claimed under ensure_unique are released for other resources
+	if r.providerData.registry != nil {
+		defer func() {
+			if !ok {
+				r.providerData.registry.Release(ids...)
+			}
+		}()
+	}
+	minDistance := int(plan.MinDistance.ValueInt64())
+	tooClose := 0
+	for collisions := 0; len(ids) < nameCount; {
+		candidate, candidateShip, candidateID, ok := generateID()
+		if !ok {
+			return cultureShipModelV2{}, false
+		}
+
+		_, duplicate := seen[candidateID]
+		if !duplicate && minDistance > 0 && withinDistance(candidateID, ids, minDistance) {
+			duplicate = true
+			tooClose++
+		}
+		// The window is only checked here, and the names admitted to it once
+		// the whole batch has been generated, so that neither a name
+		// rejected by Claim nor one of a batch that fails takes the place
+		// of one that was handed out
+		if !duplicate && r.providerData.recent != nil {
+			duplicate = r.providerData.recent.Contains(candidateID)
+		}
+		if !duplicate && r.providerData.registry != nil {
+			duplicate = !r.providerData.registry.Claim(candidateID)
+		}
+
+		if duplicate {
+			collisions++
+			if collisions == maxRetries && tooClose > 0 {
+				diagnostics.AddAttributeError(
+					path.Root("min_distance"),
+					"Ship Name Generation Error",
+					fmt.Sprintf("Only %d ship names at least %d edits apart were found after %d attempts, of which "+
+						"%d were rejected as too close to a name already chosen, but name_count is %d. Reduce "+
+						"name_count or min_distance, or raise max_retries, and retry.",
+						len(ids), minDistance, len(ids)+collisions, tooClose, nameCount),
+				)
+				return cultureShipModelV2{}, false
+			}
+			if collisions == maxRetries {
+				diagnostics.AddError(
+					"Ship Name Generation Error",
+					fmt.Sprintf("Only %d distinct ship names satisfying the configured constraints were found "+
+						"after %d attempts, but name_count is %d. Reduce name_count, relax the constraints, raise "+
+						"max_retries or disable ensure_unique and recent_window in the provider configuration and retry.",
+						len(ids), len(ids)+collisions, nameCount),
+				)
+				return cultureShipModelV2{}, false
+			}
+			continue
+		}
+
+		if len(ids) == 0 {
+			generated, ship, id = candidate, candidateShip, candidateID
+		}
+
+		seen[candidateID] = struct{}{}
+		ids = append(ids, candidateID)
+	}
+
+	sortNames(ids, plan.Sort.ValueString())
+
+	names, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return cultureShipModelV2{}, false
+	}
+
+	casedWords := applyCaseWords(generated.Words, plan.Case.ValueString())
+	words, diags := types.ListValueFrom(ctx, types.StringType, casedWords)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return cultureShipModelV2{}, false
+	}
+
+	pn := cultureShipModelV2{
+		Case:                     plan.Case,
+		CanonicalOnly:            plan.CanonicalOnly,
+		CatalogueFingerprint:     types.StringValue(r.providerData.catalogueFingerprint),
+		Decorated:                types.StringValue(decorate(ship, strings.Join(generated.Words, " "), generated.Class, plan.Decoration.ValueString())),
+		Decoration:               plan.Decoration,
+		DedupePrefix:             plan.DedupePrefix,
+		DNSSafe:                  plan.DNSSafe,
+		Exclude:                  plan.Exclude,
+		FavorIconic:              plan.FavorIconic,
+		Hash:                     types.StringValue(idHash(id)),
+		ID:                       types.StringValue(id),
+		IconicWeight:             plan.IconicWeight,
+		IncludeOnly:              plan.IncludeOnly,
+		Index:                    plan.Index,
+		Initials:                 types.StringValue(initials(generated.Words, plan.InitialsMaxLength.ValueInt64())),
+		InitialsMaxLength:        plan.InitialsMaxLength,
+		InPlaceSeparator:         plan.InPlaceSeparator,
+		IsCanonical:              types.BoolValue(generated.Canonical),
+		Keepers:                  plan.Keepers,
+		KeepersList:              plan.KeepersList,
+		KeepersRegenerateInPlace: plan.KeepersRegenerateInPlace,
+		Length:                   types.Int64Value(int64(len(id))),
+		Markdown:                 types.StringValue(markdownLink(ship, plan.MarkdownBaseURL.ValueString())),
+		MarkdownBaseURL:          plan.MarkdownBaseURL,
+		MaxLength:                plan.MaxLength,
+		MaxRetries:               plan.MaxRetries,
+		MaxWords:                 plan.MaxWords,
+		MinDistance:              plan.MinDistance,
+		MinLength:                plan.MinLength,
+		MinWords:                 plan.MinWords,
+		Name:                     types.StringValue(ship),
+		NameCount:                plan.NameCount,
+		Names:                    names,
+		NormalizeCase:            plan.NormalizeCase,
+		Phonetic:                 types.StringValue(phonetic(id)),
+		PrefixSeparator:          plan.PrefixSeparator,
+		Regex:                    plan.Regex,
+		ReplaceOnCatalogueChange: plan.ReplaceOnCatalogueChange,
+		Reversed:                 reversedName(casedWords, separator),
+		Seed:                     plan.Seed,
+		Separator:                types.StringValue(separator),
+		Slug:                     types.StringValue(slugify(generated.Name, "-")),
+		Sort:                     plan.Sort,
+		Source:                   types.StringNull(),
+		SuffixSeparator:          plan.SuffixSeparator,
+		WordCount:                types.Int64Value(int64(len(generated.Words))),
+		Words:                    words,
+	}
+
+	if generated.Class != "" {
+		pn.Class = types.StringValue(generated.Class)
+	} else {
+		pn.Class = types.StringNull()
+	}
+
+	if generated.Source != "" {
+		pn.Source = types.StringValue(generated.Source)
+	}
+
+	if prefix != "" {
+		pn.Prefix = types.StringValue(prefix)
+	} else {
+		pn.Prefix = types.StringNull()
+	}
+
+	if suffix != "" {
+		pn.Suffix = types.StringValue(suffix)
+	} else {
+		pn.Suffix = types.StringNull()
+	}
+
+	variants, diags := types.MapValueFrom(ctx, types.StringType, newIDComposer(pn).variants(casedWords))
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return cultureShipModelV2{}, false
+	}
+	pn.Variants = variants
+
+	pn.MetadataJSON = types.StringValue(metadataJSON(pn))
+
+	if r.providerData.recent != nil {
+		for _, id := range ids {
+			r.providerData.recent.Admit(id)
+		}
+	}
+
+	return pn, true
+}
+
+func (r *cultureShipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan cultureShipModelV2
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pn, ok := r.generate(ctx, plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	diags = resp.State.Set(ctx, pn)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, cultureShipIdentityModel{ID: pn.ID})...)
+	}
+}
+
+// Read does not need to refresh the state, which is already populated, but
+// sets the identity of resources created before it had one.
+func (r *cultureShipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if resp.Identity == nil || !req.Identity.Raw.IsNull() {
+		return
+	}
+
+	var id types.String
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, cultureShipIdentityModel{ID: id})...)
+}
+
+// Update ensures the plan value is copied to the state to complete the update,
+// first generating a new name if ModifyPlan left the id unknown because the
+// keepers changed with keepers_regenerate_in_place set.
+func (r *cultureShipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model cultureShipModelV2
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if model.ID.IsUnknown() {
+		var ok bool
+		if model, ok = r.generate(ctx, model, &resp.Diagnostics); !ok {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, cultureShipIdentityModel{ID: model.ID})...)
+	}
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *cultureShipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (r *cultureShipResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	schemaV0 := cultureShipSchemaV0()
+	schemaV1 := r.schemaV1(ctx)
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &schemaV0,
+			StateUpgrader: r.upgradeCultureShipStateV0toV2,
+		},
+		1: {
+			PriorSchema:   &schemaV1,
+			StateUpgrader: upgradeCultureShipStateV1toV2,
+		},
+	}
+}
+
+// ImportState accepts the full ship name, as it would appear in `id`. The
+// separator it was joined with is guessed by detectSeparator. If the name
+// contains a known ship, the words before and after it become the prefix and
+// suffix; otherwise the whole name is taken as the ship name. When imported
+// by identity, the name is the identity's id.
+func (r *cultureShipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := req.ID
+	if id == "" && req.Identity != nil {
+		var identity cultureShipIdentityModel
+
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		id = identity.ID.ValueString()
+	}
+	separator := detectSeparator(r.generator, id)
+
+	prefix, ship, suffix, known := splitImportID(r.generator, id, separator)
+	shipWords := knownWords(ship, separator, known)
+
+	names, diags := types.ListValueFrom(ctx, types.StringType, []string{id})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	words, diags := types.ListValueFrom(ctx, types.StringType, shipWords)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := cultureShipModelV2{
+		Case:                     types.StringValue(detectCase(ship, separator)),
+		CanonicalOnly:            types.BoolValue(false),
+		CatalogueFingerprint:     types.StringValue(r.providerData.catalogueFingerprint),
+		Class:                    types.StringNull(),
+		Decorated:                types.StringValue(ship),
+		Decoration:               types.StringValue(decorationNone),
+		DedupePrefix:             types.BoolValue(false),
+		DNSSafe:                  types.BoolValue(false),
+		Exclude:                  types.ListNull(types.StringType),
+		FavorIconic:              types.BoolValue(false),
+		Hash:                     types.StringValue(idHash(id)),
+		ID:                       types.StringValue(id),
+		IconicWeight:             types.Int64Value(spaceships.DefaultIconicWeight),
+		IncludeOnly:              types.ListNull(types.StringType),
+		Index:                    types.Int64Null(),
+		Initials:                 types.StringValue(initials(shipWords, 0)),
+		InitialsMaxLength:        types.Int64Null(),
+		InPlaceSeparator:         types.BoolValue(false),
+		IsCanonical:              types.BoolValue(known.Canonical),
+		Keepers:                  types.DynamicNull(),
+		KeepersList:              types.ListNull(types.StringType),
+		KeepersRegenerateInPlace: types.BoolValue(false),
+		Length:                   types.Int64Value(int64(len(id))),
+		Markdown:                 types.StringValue(markdownLink(ship, defaultMarkdownBaseURL)),
+		MarkdownBaseURL:          types.StringValue(defaultMarkdownBaseURL),
+		MaxLength:                types.Int64Null(),
+		MaxRetries:               types.Int64Value(defaultMaxRetries),
+		MaxWords:                 types.Int64Null(),
+		MinDistance:              types.Int64Null(),
+		MinLength:                types.Int64Null(),
+		MinWords:                 types.Int64Null(),
+		Name:                     types.StringValue(ship),
+		NameCount:                types.Int64Value(1),
+		Names:                    names,
+		NormalizeCase:            types.BoolValue(false),
+		Phonetic:                 types.StringValue(phonetic(id)),
+		Prefix:                   types.StringNull(),
+		PrefixSeparator:          types.StringNull(),
+		Regex:                    types.StringNull(),
+		ReplaceOnCatalogueChange: types.BoolValue(false),
+		Reversed:                 reversedName(shipWords, separator),
+		Seed:                     types.Int64Null(),
+		Separator:                types.StringValue(separator),
+		Slug:                     types.StringValue(slugify(ship, "-")),
+		Sort:                     types.StringValue(sortNone),
+		Source:                   types.StringNull(),
+		Suffix:                   types.StringNull(),
+		SuffixSeparator:          types.StringNull(),
+		WordCount:                types.Int64Value(int64(len(shipWords))),
+		Words:                    words,
+	}
+
+	if known.Class != "" {
+		state.Class = types.StringValue(known.Class)
+	}
+
+	if known.Source != "" {
+		state.Source = types.StringValue(known.Source)
+	}
+
+	if prefix != "" {
+		state.Prefix = types.StringValue(prefix)
+	}
+
+	if suffix != "" {
+		state.Suffix = types.StringValue(suffix)
+	}
+
+	variants, diags := types.MapValueFrom(ctx, types.StringType, newIDComposer(state).variants(shipWords))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Variants = variants
+
+	state.MetadataJSON = types.StringValue(metadataJSON(state))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, cultureShipIdentityModel{ID: state.ID})...)
+	}
+}
+
+// cultureShipSchemaV0 is the schema of culture_ship before the name was
+// broken down into computed attributes.
+func cultureShipSchemaV0() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"keepers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"prefix": schema.StringAttribute{
+				Optional: true,
+			},
+			"separator": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// schemaV1 is the schema of culture_ship before keepers could hold values
+// other than strings. It is otherwise the same as the current schema.
+func (r *cultureShipResource) schemaV1(ctx context.Context) schema.Schema {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+
+	attributes := make(map[string]schema.Attribute, len(resp.Schema.Attributes))
+	for name, attribute := range resp.Schema.Attributes {
+		attributes[name] = attribute
+	}
+
+	attributes["keepers"] = schema.MapAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+	}
+
+	return schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// upgradeCultureShipStateV1toV2 carries the state over unchanged: a map of
+// strings is a valid value for the dynamic keepers attribute.
+func upgradeCultureShipStateV1toV2(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var attributes map[string]tftypes.Value
+
+	if err := req.State.Raw.As(&attributes); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Upgrade Resource State",
+			fmt.Sprintf("Unable to read the prior culture_ship state: %s. "+
+				"Please report this issue to the provider developers.", err),
+		)
+		return
+	}
+
+	resp.State.Raw = tftypes.NewValue(resp.State.Schema.Type().TerraformType(ctx), attributes)
+}
+
+// upgradeCultureShipStateV0toV2 backfills the attributes added in version 1
+// by recomputing them from the stored id and separator. Version 0 always
+// lowercased the ship name and generated a single name without a suffix.
+func (r *cultureShipResource) upgradeCultureShipStateV0toV2(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var cultureShipDataV0 cultureShipModelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &cultureShipDataV0)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := cultureShipDataV0.ID.ValueString()
+	separator := cultureShipDataV0.Separator.ValueString()
+	if cultureShipDataV0.Separator.IsNull() {
+		separator = "-"
+	}
+
+	ship := id
+	if prefix := cultureShipDataV0.Prefix.ValueString(); prefix != "" {
+		ship = strings.TrimPrefix(id, prefix+separator)
+	}
+
+	known, _ := r.generator.Find(ship, separator)
+	shipWords := knownWords(ship, separator, known)
+
+	names, diags := types.ListValueFrom(ctx, types.StringType, []string{id})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	words, diags := types.ListValueFrom(ctx, types.StringType, shipWords)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cultureShipDataV2 := cultureShipModelV2{
+		Case:                     types.StringValue(caseLower),
+		CanonicalOnly:            types.BoolValue(false),
+		CatalogueFingerprint:     types.StringNull(),
+		Class:                    types.StringNull(),
+		Decorated:                types.StringValue(ship),
+		Decoration:               types.StringValue(decorationNone),
+		DedupePrefix:             types.BoolValue(false),
+		DNSSafe:                  types.BoolValue(false),
+		Exclude:                  types.ListNull(types.StringType),
+		FavorIconic:              types.BoolValue(false),
+		Hash:                     types.StringValue(idHash(id)),
+		ID:                       cultureShipDataV0.ID,
+		IconicWeight:             types.Int64Value(spaceships.DefaultIconicWeight),
+		IncludeOnly:              types.ListNull(types.StringType),
+		Index:                    types.Int64Null(),
+		Initials:                 types.StringValue(initials(shipWords, 0)),
+		InitialsMaxLength:        types.Int64Null(),
+		InPlaceSeparator:         types.BoolValue(false),
+		IsCanonical:              types.BoolValue(false),
+		Keepers:                  types.DynamicNull(),
+		KeepersList:              types.ListNull(types.StringType),
+		KeepersRegenerateInPlace: types.BoolValue(false),
+		Length:                   types.Int64Value(int64(len(id))),
+		Markdown:                 types.StringValue(markdownLink(ship, defaultMarkdownBaseURL)),
+		MarkdownBaseURL:          types.StringValue(defaultMarkdownBaseURL),
+		MaxLength:                types.Int64Null(),
+		MaxRetries:               types.Int64Value(defaultMaxRetries),
+		MaxWords:                 types.Int64Null(),
+		MinDistance:              types.Int64Null(),
+		MinLength:                types.Int64Null(),
+		MinWords:                 types.Int64Null(),
+		Name:                     types.StringValue(ship),
+		NameCount:                types.Int64Value(1),
+		Names:                    names,
+		NormalizeCase:            types.BoolValue(false),
+		Phonetic:                 types.StringValue(phonetic(id)),
+		Prefix:                   cultureShipDataV0.Prefix,
+		PrefixSeparator:          types.StringNull(),
+		Regex:                    types.StringNull(),
+		ReplaceOnCatalogueChange: types.BoolValue(false),
+		Reversed:                 reversedName(shipWords, separator),
+		Seed:                     types.Int64Null(),
+		Separator:                types.StringValue(separator),
+		Slug:                     types.StringValue(slugify(ship, "-")),
+		Sort:                     types.StringValue(sortNone),
+		Source:                   types.StringNull(),
+		Suffix:                   types.StringNull(),
+		SuffixSeparator:          types.StringNull(),
+		WordCount:                types.Int64Value(int64(len(shipWords))),
+		Words:                    words,
+	}
+
+	if !cultureShipDataV0.Keepers.IsNull() {
+		cultureShipDataV2.Keepers = types.DynamicValue(cultureShipDataV0.Keepers)
+	}
+
+	if known.Name != "" {
+		cultureShipDataV2.IsCanonical = types.BoolValue(known.Canonical)
+
+		if known.Class != "" {
+			cultureShipDataV2.Class = types.StringValue(known.Class)
+		}
+
+		if known.Source != "" {
+			cultureShipDataV2.Source = types.StringValue(known.Source)
+		}
+	}
+
+	variants, diags := types.MapValueFrom(ctx, types.StringType, newIDComposer(cultureShipDataV2).variants(shipWords))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cultureShipDataV2.Variants = variants
+
+	cultureShipDataV2.MetadataJSON = types.StringValue(metadataJSON(cultureShipDataV2))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, cultureShipDataV2)...)
 }
 
 type cultureShipModelV0 struct {
@@ -141,3 +1858,701 @@ type cultureShipModelV0 struct {
 	Prefix    types.String `tfsdk:"prefix"`
 	Separator types.String `tfsdk:"separator"`
 }
+
+type cultureShipModelV2 struct {
+	Case                     types.String  `tfsdk:"case"`
+	CanonicalOnly            types.Bool    `tfsdk:"canonical_only"`
+	CatalogueFingerprint     types.String  `tfsdk:"catalogue_fingerprint"`
+	Class                    types.String  `tfsdk:"class"`
+	Decorated                types.String  `tfsdk:"decorated"`
+	Decoration               types.String  `tfsdk:"decoration"`
+	DedupePrefix             types.Bool    `tfsdk:"dedupe_prefix"`
+	DNSSafe                  types.Bool    `tfsdk:"dns_safe"`
+	Exclude                  types.List    `tfsdk:"exclude"`
+	FavorIconic              types.Bool    `tfsdk:"favor_iconic"`
+	Hash                     types.String  `tfsdk:"hash"`
+	ID                       types.String  `tfsdk:"id"`
+	IconicWeight             types.Int64   `tfsdk:"iconic_weight"`
+	IncludeOnly              types.List    `tfsdk:"include_only"`
+	Index                    types.Int64   `tfsdk:"index"`
+	Initials                 types.String  `tfsdk:"initials"`
+	InitialsMaxLength        types.Int64   `tfsdk:"initials_max_length"`
+	InPlaceSeparator         types.Bool    `tfsdk:"in_place_separator"`
+	IsCanonical              types.Bool    `tfsdk:"is_canonical"`
+	Keepers                  types.Dynamic `tfsdk:"keepers"`
+	KeepersList              types.List    `tfsdk:"keepers_list"`
+	KeepersRegenerateInPlace types.Bool    `tfsdk:"keepers_regenerate_in_place"`
+	Length                   types.Int64   `tfsdk:"length"`
+	Markdown                 types.String  `tfsdk:"markdown"`
+	MarkdownBaseURL          types.String  `tfsdk:"markdown_base_url"`
+	MaxLength                types.Int64   `tfsdk:"max_length"`
+	MaxRetries               types.Int64   `tfsdk:"max_retries"`
+	MaxWords                 types.Int64   `tfsdk:"max_words"`
+	MetadataJSON             types.String  `tfsdk:"metadata_json"`
+	MinDistance              types.Int64   `tfsdk:"min_distance"`
+	MinLength                types.Int64   `tfsdk:"min_length"`
+	MinWords                 types.Int64   `tfsdk:"min_words"`
+	Name                     types.String  `tfsdk:"name"`
+	NameCount                types.Int64   `tfsdk:"name_count"`
+	Names                    types.List    `tfsdk:"names"`
+	NormalizeCase            types.Bool    `tfsdk:"normalize_case"`
+	Phonetic                 types.String  `tfsdk:"phonetic"`
+	Prefix                   types.String  `tfsdk:"prefix"`
+	PrefixSeparator          types.String  `tfsdk:"prefix_separator"`
+	Regex                    types.String  `tfsdk:"regex"`
+	ReplaceOnCatalogueChange types.Bool    `tfsdk:"replace_on_catalogue_change"`
+	Reversed                 types.String  `tfsdk:"reversed"`
+	Seed                     types.Int64   `tfsdk:"seed"`
+	Separator                types.String  `tfsdk:"separator"`
+	Slug                     types.String  `tfsdk:"slug"`
+	Sort                     types.String  `tfsdk:"sort"`
+	Source                   types.String  `tfsdk:"source"`
+	Suffix                   types.String  `tfsdk:"suffix"`
+	SuffixSeparator          types.String  `tfsdk:"suffix_separator"`
+	Variants                 types.Map     `tfsdk:"variants"`
+	WordCount                types.Int64   `tfsdk:"word_count"`
+	Words                    types.List    `tfsdk:"words"`
+}
+
+// separatorRegexp matches separators that cannot be confused with the words
+// of a ship name, so that the composed id can still be split back apart.
+var separatorRegexp = regexp.MustCompile(`^[^\p{L}\p{N}]*$`)
+
+// separatorPrintableRegexp matches separators free of control characters and
+// whitespace, other than plain spaces, which would produce malformed names.
+// Tabs, newlines and carriage returns are left to NoLineBreaksOrTabs, which
+// every separator is also validated by, so that each is reported once.
+var separatorPrintableRegexp = regexp.MustCompile(`^(?:[^\p{Cc}\p{Z}]|[ \t\n\r])*$`)
+
+// separatorValidators are the validators of every separator attribute of the
+// provider, its resources and its data sources.
+func separatorValidators() []validator.String {
+	return []validator.String{
+		stringvalidators.NoLineBreaksOrTabs(),
+		stringvalidator.RegexMatches(separatorRegexp, "must not contain letters or digits"),
+		stringvalidator.RegexMatches(separatorPrintableRegexp, "must not contain control characters or whitespace other than spaces"),
+	}
+}
+
+// smallPoolSize is the number of matching ship names below which Create warns
+// that generated names are likely to repeat.
+const smallPoolSize = 5
+
+// defaultMaxRetries is the default for max_retries, which bounds how many
+// ship names Create draws while looking for one that satisfies the configured
+// constraints.
+const defaultMaxRetries = 1000
+
+// composeID joins the optional prefix and suffix onto the ship name.
+func composeID(prefix, ship, suffix, separator string) string {
+	return composeIDWithSeparators(prefix, separator, ship, suffix, separator)
+}
+
+// composeIDWithSeparators is like composeID, but joins the prefix and suffix
+// with their own separators.
+func composeIDWithSeparators(prefix, prefixSeparator, ship, suffix, suffixSeparator string) string {
+	if prefix != "" {
+		ship = fmt.Sprintf("%s%s%s", prefix, prefixSeparator, ship)
+	}
+
+	if suffix != "" {
+		ship = fmt.Sprintf("%s%s%s", ship, suffixSeparator, suffix)
+	}
+
+	return ship
+}
+
+// wordCount returns the number of words in a name joined by separator. A name
+// joined without a separator is a single word.
+func wordCount(name, separator string) int {
+	return len(nameWords(name, separator))
+}
+
+// nameWords returns the words of a name joined by separator. A name joined
+// without a separator is a single word.
+func nameWords(name, separator string) []string {
+	if separator == "" {
+		return []string{name}
+	}
+
+	return strings.Split(name, separator)
+}
+
+// knownWords returns the words of name, joined by separator, as known ship
+// holds them, so that a word such as "Character-Forming" is not split on a
+// separator it contains. The words keep the case they have in name. Without
+// a known ship, or if name does not split into its words, they are split on
+// separator as nameWords splits them.
+func knownWords(name, separator string, known spaceships.Ship) []string {
+	pieces := nameWords(name, separator)
+	if known.Name == "" || separator == "" {
+		return pieces
+	}
+
+	words := make([]string, 0, len(known.Words))
+	for _, word := range known.Words {
+		n := strings.Count(word, separator) + 1
+		if n > len(pieces) {
+			return nameWords(name, separator)
+		}
+
+		words = append(words, strings.Join(pieces[:n], separator))
+		pieces = pieces[n:]
+	}
+	if len(pieces) > 0 {
+		return nameWords(name, separator)
+	}
+
+	return words
+}
+
+// composedLengthRange returns the lengths of the shortest and longest ids that
+// composeIDWithSeparators can produce from the ships of the generator for the
+// given prefix, suffix and separators.
+func composedLengthRange(ships spaceships.Generator, prefix, prefixSeparator, suffix, suffixSeparator, separator string) (int, int) {
+	shortest, longest := ships.LengthRange(separator)
+	extra := len(composeIDWithSeparators(prefix, prefixSeparator, "", suffix, suffixSeparator))
+
+	return shortest + extra, longest + extra
+}
+
+// separatorRequiresReplace replaces the resource when its separator changes,
+// unless in_place_separator is set and the stored name can be split back into
+// its words.
+func separatorRequiresReplace(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	var inPlace types.Bool
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("in_place_separator"), &inPlace)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.RequiresReplace = !inPlace.ValueBool() || req.PlanValue.IsUnknown() || req.StateValue.ValueString() == ""
+}
+
+// keepersRequiresReplaceModifier applies the plan modifier of keepers or
+// keepers_list, except that a change which would replace the resource leaves
+// it to ModifyPlan to regenerate the name in place when
+// keepers_regenerate_in_place is set.
+type keepersRequiresReplaceModifier struct {
+	dynamic planmodifier.Dynamic
+	list    planmodifier.List
+}
+
+func (m keepersRequiresReplaceModifier) PlanModifyDynamic(ctx context.Context, req planmodifier.DynamicRequest, resp *planmodifier.DynamicResponse) {
+	m.dynamic.PlanModifyDynamic(ctx, req, resp)
+	if resp.RequiresReplace {
+		resp.RequiresReplace = !keepersRegenerateInPlace(ctx, req.Plan, &resp.Diagnostics)
+	}
+}
+
+func (m keepersRequiresReplaceModifier) PlanModifyList(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	m.list.PlanModifyList(ctx, req, resp)
+	if resp.RequiresReplace {
+		resp.RequiresReplace = !keepersRegenerateInPlace(ctx, req.Plan, &resp.Diagnostics)
+	}
+}
+
+func (m keepersRequiresReplaceModifier) Description(ctx context.Context) string {
+	return "If the value of this attribute changes, Terraform will destroy and recreate the resource, " +
+		"unless keepers_regenerate_in_place is true."
+}
+
+func (m keepersRequiresReplaceModifier) MarkdownDescription(ctx context.Context) string {
+	return "If the value of this attribute changes, Terraform will destroy and recreate the resource, " +
+		"unless `keepers_regenerate_in_place` is true."
+}
+
+// keepersRegenerateInPlace reports whether keepers_regenerate_in_place is set
+// in plan.
+func keepersRegenerateInPlace(ctx context.Context, plan tfsdk.Plan, diagnostics *diag.Diagnostics) bool {
+	var inPlace types.Bool
+
+	diagnostics.Append(plan.GetAttribute(ctx, path.Root("keepers_regenerate_in_place"), &inPlace)...)
+
+	return inPlace.ValueBool()
+}
+
+// keepersChanged reports whether keepers or keepers_list changed in a way
+// that their plan modifiers would replace the resource for, were
+// keepers_regenerate_in_place not set.
+func keepersChanged(ctx context.Context, req resource.ModifyPlanRequest) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var planKeepers, stateKeepers types.Dynamic
+	var planKeepersList, stateKeepersList types.List
+
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("keepers"), &planKeepers)...)
+	diags.Append(req.State.GetAttribute(ctx, path.Root("keepers"), &stateKeepers)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("keepers_list"), &planKeepersList)...)
+	diags.Append(req.State.GetAttribute(ctx, path.Root("keepers_list"), &stateKeepersList)...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	var dynamicResp planmodifier.DynamicResponse
+	mapplanmodifiers.DynamicRequiresReplaceIfValuesNotNull().PlanModifyDynamic(ctx, planmodifier.DynamicRequest{
+		Path:        path.Root("keepers"),
+		Config:      req.Config,
+		ConfigValue: planKeepers,
+		Plan:        req.Plan,
+		PlanValue:   planKeepers,
+		State:       req.State,
+		StateValue:  stateKeepers,
+	}, &dynamicResp)
+	diags.Append(dynamicResp.Diagnostics...)
+
+	var listResp planmodifier.ListResponse
+	listplanmodifiers.RequiresReplaceIfValuesNotNull().PlanModifyList(ctx, planmodifier.ListRequest{
+		Path:        path.Root("keepers_list"),
+		Config:      req.Config,
+		ConfigValue: planKeepersList,
+		Plan:        req.Plan,
+		PlanValue:   planKeepersList,
+		State:       req.State,
+		StateValue:  stateKeepersList,
+	}, &listResp)
+	diags.Append(listResp.Diagnostics...)
+
+	return dynamicResp.RequiresReplace || listResp.RequiresReplace, diags
+}
+
+// idComposer composes ids from ship names the way Create does for a given
+// configuration.
+type idComposer struct {
+	prefix, prefixSeparator string
+	suffix, suffixSeparator string
+	separator               string
+	dedupePrefix            bool
+}
+
+func newIDComposer(m cultureShipModelV2) idComposer {
+	c := idComposer{
+		prefix:          m.Prefix.ValueString(),
+		prefixSeparator: m.Separator.ValueString(),
+		suffix:          m.Suffix.ValueString(),
+		suffixSeparator: m.Separator.ValueString(),
+		separator:       m.Separator.ValueString(),
+		dedupePrefix:    m.DedupePrefix.ValueBool(),
+	}
+
+	if m.NormalizeCase.ValueBool() {
+		c.prefix = applyCase(c.prefix, c.separator, m.Case.ValueString())
+		c.suffix = applyCase(c.suffix, c.separator, m.Case.ValueString())
+	}
+
+	if !m.PrefixSeparator.IsNull() {
+		c.prefixSeparator = m.PrefixSeparator.ValueString()
+	}
+
+	if !m.SuffixSeparator.IsNull() {
+		c.suffixSeparator = m.SuffixSeparator.ValueString()
+	}
+
+	return c
+}
+
+func (c idComposer) compose(ship string) string {
+	prefix := c.prefix
+	if c.dedupePrefix && startsWithWord(ship, prefix, c.separator) {
+		prefix = ""
+	}
+
+	return composeIDWithSeparators(prefix, c.prefixSeparator, ship, c.suffix, c.suffixSeparator)
+}
+
+// variantSeparators are the separators of the variants attribute, by key.
+var variantSeparators = map[string]string{
+	"dash":       "-",
+	"space":      " ",
+	"underscore": "_",
+}
+
+// variants returns the id composed by c for the ship with the given words,
+// with each of variantSeparators in place of every separator c joins with.
+// The words are taken as they are rather than split from a joined name, so
+// that a word containing the separator is kept whole.
+func (c idComposer) variants(words []string) map[string]string {
+	variants := make(map[string]string, len(variantSeparators))
+	for key, separator := range variantSeparators {
+		v := c
+		v.prefixSeparator, v.suffixSeparator, v.separator = separator, separator, separator
+		variants[key] = v.compose(strings.Join(words, separator))
+	}
+
+	return variants
+}
+
+// ship returns the ship name within an id composed by c. When dedupePrefix
+// is set, an id starting with the prefix may equally be a prefixed ship or a
+// ship that already began with the prefix; the id is taken as the whole ship
+// name only if that is a known ship and the name without the prefix is not.
+func (c idComposer) ship(id string) string {
+	if c.suffix != "" {
+		id = strings.TrimSuffix(id, c.suffixSeparator+c.suffix)
+	}
+
+	if c.prefix == "" {
+		return id
+	}
+
+	ship := strings.TrimPrefix(id, c.prefix+c.prefixSeparator)
+	if c.dedupePrefix && startsWithWord(id, c.prefix, c.separator) &&
+		(ship == id || startsWithWord(ship, c.prefix, c.separator) ||
+			spaceships.Contains(id) && !spaceships.Contains(ship)) {
+		return id
+	}
+
+	return ship
+}
+
+// startsWithWord reports whether the first words of ship, joined by the
+// separator, are prefix, compared case-insensitively.
+func startsWithWord(ship, prefix, separator string) bool {
+	if prefix == "" {
+		return false
+	}
+
+	ship, prefix = strings.ToLower(ship), strings.ToLower(prefix)
+
+	return ship == prefix || strings.HasPrefix(ship, prefix+strings.ToLower(separator))
+}
+
+// reseparate rejoins the words of a ship name joined by from with to.
+func reseparate(ship, from, to string) string {
+	return strings.Join(strings.Split(ship, from), to)
+}
+
+// reseparateShip rejoins the words of a generated ship name joined by from
+// with to, as Create would have joined them. The words of a ship the
+// generator knows, including those of catalogue_path and extra_names, are
+// taken as generated, so that a word containing from is kept whole; any
+// other name is split on from.
+func reseparateShip(ships spaceships.Generator, ship, from, to, mode string) string {
+	if known, ok := ships.Find(ship, from); ok {
+		return applyCase(strings.Join(known.Words, to), to, mode)
+	}
+
+	return reseparate(ship, from, to)
+}
+
+// importSeparators are the separators detectSeparator considers, in order of
+// preference.
+var importSeparators = []string{"-", "_", " "}
+
+// detectSeparator guesses which of importSeparators joins the words of an
+// imported id. It prefers the separator that splits out the longest known
+// ship, then the one occurring most often, and falls back to "-" for an id
+// of a single word.
+//
+// The guess can be wrong: multi-character separators, and separators other
+// than those in importSeparators, are never detected, and an unknown ship
+// whose words are joined by one separator but contain another, such as
+// "Character-Forming" joined by "_", may be split on the wrong one. Set
+// separator in the configuration after importing to correct it.
+func detectSeparator(ships spaceships.Generator, id string) string {
+	best, bestWords := "", 0
+	for _, separator := range importSeparators {
+		if !strings.Contains(id, separator) {
+			continue
+		}
+
+		if _, ship, _, known := splitImportID(ships, id, separator); known.Name != "" {
+			if n := wordCount(ship, separator); n > bestWords {
+				best, bestWords = separator, n
+			}
+		}
+	}
+
+	if best != "" {
+		return best
+	}
+
+	best, most := "-", 0
+	for _, separator := range importSeparators {
+		if n := strings.Count(id, separator); n > most {
+			best, most = separator, n
+		}
+	}
+
+	return best
+}
+
+// splitImportID finds the longest run of words in id that names a ship of
+// ships, and returns the words either side of it as the prefix and suffix.
+// If no known ship is found, the whole id is returned as the ship name.
+func splitImportID(ships spaceships.Generator, id, separator string) (string, string, string, spaceships.Ship) {
+	words := strings.Split(id, separator)
+
+	start, end := 0, 0
+	var known spaceships.Ship
+	for i := range words {
+		for j := len(words); j > i+end-start; j-- {
+			if ship, ok := ships.Find(strings.Join(words[i:j], separator), separator); ok {
+				start, end, known = i, j, ship
+				break
+			}
+		}
+	}
+
+	if end == 0 {
+		return "", id, "", spaceships.Ship{}
+	}
+
+	return strings.Join(words[:start], separator),
+		strings.Join(words[start:end], separator),
+		strings.Join(words[end:], separator),
+		known
+}
+
+const (
+	sortNone   = "none"
+	sortAsc    = "asc"
+	sortDesc   = "desc"
+	sortLength = "length"
+)
+
+// sortNames orders names in place according to the given sort mode, leaving
+// them in the order they were generated for sortNone.
+func sortNames(names []string, mode string) {
+	switch mode {
+	case sortAsc:
+		sort.Strings(names)
+	case sortDesc:
+		sort.Sort(sort.Reverse(sort.StringSlice(names)))
+	case sortLength:
+		sort.Slice(names, func(i, j int) bool {
+			if len(names[i]) != len(names[j]) {
+				return len(names[i]) < len(names[j])
+			}
+			return names[i] < names[j]
+		})
+	}
+}
+
+const (
+	caseLower    = "lower"
+	caseUpper    = "upper"
+	caseTitle    = "title"
+	caseOriginal = "original"
+)
+
+// applyCase changes the capitalisation of a generated name according to the
+// given case mode. Words are delimited by separator when title casing.
+func applyCase(name, separator, mode string) string {
+	switch mode {
+	case caseUpper:
+		return strings.ToUpper(name)
+	case caseTitle:
+		if separator == "" {
+			return titleWord(name)
+		}
+
+		return strings.Join(applyCaseWords(strings.Split(name, separator), mode), separator)
+	case caseOriginal:
+		return name
+	default:
+		return strings.ToLower(name)
+	}
+}
+
+// applyCaseWords applies the case mode to each of the words of a name, in
+// order, returning them as a new slice.
+func applyCaseWords(words []string, mode string) []string {
+	cased := make([]string, len(words))
+	for i, word := range words {
+		if mode == caseTitle && i > 0 && i < len(words)-1 && isTitleSmallWord(word) {
+			cased[i] = strings.ToLower(word)
+			continue
+		}
+
+		cased[i] = applyCase(word, "", mode)
+	}
+
+	return cased
+}
+
+// titleSmallWords are the articles, coordinating conjunctions and short
+// prepositions that title case leaves lowercase, as most style guides do,
+// unless they are the first or last word of a name.
+var titleSmallWords = map[string]struct{}{
+	"a": {}, "an": {}, "the": {},
+	"and": {}, "but": {}, "nor": {}, "or": {},
+	"as": {}, "at": {}, "by": {}, "for": {}, "in": {}, "of": {}, "off": {}, "on": {}, "per": {}, "to": {}, "via": {},
+}
+
+// isTitleSmallWord reports whether word, ignoring case and any punctuation
+// around it, is one of titleSmallWords.
+func isTitleSmallWord(word string) bool {
+	_, ok := titleSmallWords[strings.ToLower(strings.TrimFunc(word, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	}))]
+	return ok
+}
+
+// detectCase returns the case mode that applyCase would need to produce name,
+// preferring lower, then upper, then title, and otherwise original.
+func detectCase(name, separator string) string {
+	for _, mode := range []string{caseLower, caseUpper, caseTitle} {
+		if applyCase(name, separator, mode) == name {
+			return mode
+		}
+	}
+
+	return caseOriginal
+}
+
+// initials returns the first letter or digit of each of words, in upper
+// case, skipping words with neither. If maxLength is positive, only the
+// initials of the first maxLength such words are kept.
+func initials(words []string, maxLength int64) string {
+	var b strings.Builder
+	n := int64(0)
+
+	for _, word := range words {
+		if maxLength > 0 && n == maxLength {
+			break
+		}
+
+		i := strings.IndexFunc(word, func(r rune) bool {
+			return unicode.IsLetter(r) || unicode.IsDigit(r)
+		})
+		if i < 0 {
+			continue
+		}
+
+		r, _ := utf8.DecodeRuneInString(word[i:])
+		b.WriteRune(unicode.ToUpper(r))
+		n++
+	}
+
+	return b.String()
+}
+
+// reversedName joins words in reverse order with separator, or returns null if
+// there are fewer than two words to reverse.
+func reversedName(words []string, separator string) types.String {
+	if len(words) < 2 {
+		return types.StringNull()
+	}
+
+	reversed := make([]string, len(words))
+	for i, word := range words {
+		reversed[len(words)-1-i] = word
+	}
+
+	return types.StringValue(strings.Join(reversed, separator))
+}
+
+// defaultMarkdownBaseURL is the URL markdown links ship names under when
+// markdown_base_url is not set.
+const defaultMarkdownBaseURL = "https://theculture.fandom.com/wiki"
+
+const (
+	decorationNone      = "none"
+	decorationBrackets  = "brackets"
+	decorationQuotes    = "quotes"
+	decorationGSVPrefix = "gsv_prefix"
+)
+
+// decorate dresses up the generated ship name according to the given
+// decoration mode. book is the ship as written in the books and class its
+// abbreviation, if known, for decorationGSVPrefix.
+func decorate(name, book, class, mode string) string {
+	switch mode {
+	case decorationBrackets:
+		return "[" + name + "]"
+	case decorationQuotes:
+		return `"` + name + `"`
+	case decorationGSVPrefix:
+		if class == "" {
+			return book
+		}
+		return class + " " + book
+	default:
+		return name
+	}
+}
+
+// markdownLink formats name as a Markdown link to its entry under baseURL.
+func markdownLink(name, baseURL string) string {
+	return fmt.Sprintf("[%s](%s/%s)", name, strings.TrimSuffix(baseURL, "/"), url.PathEscape(name))
+}
+
+// cultureShipMetadata is the JSON object stored in metadata_json.
+type cultureShipMetadata struct {
+	Name      string  `json:"name"`
+	Class     *string `json:"class"`
+	Source    *string `json:"source"`
+	WordCount int64   `json:"word_count"`
+	Length    int64   `json:"length"`
+}
+
+// metadataJSON marshals the metadata_json object for m.
+func metadataJSON(m cultureShipModelV2) string {
+	metadata := cultureShipMetadata{
+		Name:      m.Name.ValueString(),
+		Class:     m.Class.ValueStringPointer(),
+		Source:    m.Source.ValueStringPointer(),
+		WordCount: m.WordCount.ValueInt64(),
+		Length:    m.Length.ValueInt64(),
+	}
+
+	// Marshaling cannot fail, as the struct holds only strings and integers.
+	b, _ := json.Marshal(metadata)
+
+	return string(b)
+}
+
+// idHash returns the first 8 hexadecimal characters of the SHA-256 hash of id.
+func idHash(id string) string {
+	sum := sha256.Sum256([]byte(id))
+	return hex.EncodeToString(sum[:4])
+}
+
+// slugify lowercases name and replaces every run of characters other than
+// letters and digits with a single separator, trimming any at either end.
+func slugify(name, separator string) string {
+	var b strings.Builder
+	pending := false
+
+	for _, r := range strings.ToLower(name) {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			pending = b.Len() > 0
+			continue
+		}
+
+		if pending {
+			b.WriteString(separator)
+			pending = false
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+// dnsLabelMaxLength is the maximum length of a DNS label, as defined by
+// RFC 1123.
+const dnsLabelMaxLength = 63
+
+// dnsLabel lowercases name and replaces every run of characters other than
+// ASCII letters and digits with a single hyphen, trimming any at either end,
+// so that the result is a valid DNS label unless it is empty or too long.
+func dnsLabel(name string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return (r < 'a' || r > 'z') && (r < '0' || r > '9')
+	}), "-")
+}
+
+// titleWord capitalises the first letter of word and lowercases the rest. The
+// first letter is mapped to title case rather than upper case, which differs
+// for the few letters, such as the digraph "ǆ", whose capital is written "ǅ"
+// at the start of a word.
+func titleWord(word string) string {
+	r, size := utf8.DecodeRuneInString(word)
+	if r == utf8.RuneError {
+		return word
+	}
+
+	return string(unicode.ToTitle(r)) + strings.ToLower(word[size:])
+}
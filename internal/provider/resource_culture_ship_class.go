@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	mapplanmodifiers "github.com/matthewbaggett/terraform-provider-fun-names/internal/planmodifiers/map"
+	"github.com/matthewbaggett/terraform-provider-fun-names/internal/spaceships"
+)
+
+var (
+	_ resource.Resource               = (*cultureShipClassResource)(nil)
+	_ resource.ResourceWithConfigure  = (*cultureShipClassResource)(nil)
+	_ resource.ResourceWithModifyPlan = (*cultureShipClassResource)(nil)
+)
+
+// NewCultureGSVResource returns the culture_gsv resource, which generates
+// names of General Systems Vehicles.
+func NewCultureGSVResource() resource.Resource {
+	return &cultureShipClassResource{
+		class:     spaceships.ClassGSV,
+		name:      "General Systems Vehicle",
+		generator: spaceships.CatalogueGenerator{},
+	}
+}
+
+// NewCultureGCUResource returns the culture_gcu resource, which generates
+// names of General Contact Units.
+func NewCultureGCUResource() resource.Resource {
+	return &cultureShipClassResource{
+		class:     spaceships.ClassGCU,
+		name:      "General Contact Unit",
+		generator: spaceships.CatalogueGenerator{},
+	}
+}
+
+// cultureShipClassResource generates names of the ships of a single class,
+// like a culture_ship with its class set, but with only the basic prefix,
+// separator and keepers attributes. Names are generated by culture_ship, so
+// the provider's default_separator, single_character_separator, ensure_unique
+// and recent_window apply to them alike.
+type cultureShipClassResource struct {
+	// class is the abbreviation of the class of ship, which also names the
+	// resource.
+	class string
+	// name is the full name of the class of ship, for documentation.
+	name string
+
+	providerData providerData
+	generator    spaceships.Generator
+}
+
+func (r *cultureShipClassResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = *data
+	r.generator = data.ships
+}
+
+func (r *cultureShipClassResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_culture_" + strings.ToLower(r.class)
+}
+
+func (r *cultureShipClassResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("The resource `culture_%s` returns a name of a %s from the Culture Series by Ian M Banks\n"+
+			"\n"+
+			"It is the same as a `culture_ship` resource with `class = \"%s\"`, for configurations that only "+
+			"ever want ships of that class.\n", strings.ToLower(r.class), r.name, r.class),
+		Attributes: map[string]schema.Attribute{
+			"keepers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifiers.RequiresReplaceIfValuesNotNull(),
+				},
+			},
+			"prefix": schema.StringAttribute{
+				Description: "A string to prefix the name with.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"separator": schema.StringAttribute{
+				Description: "The characters to separate words in the ship name, and to join the prefix to it. " +
+					"May be more than one character long, but may not contain letters or digits. Defaults to " +
+					"the provider's `default_separator`, or \"-\" if that is not set either.",
+				Optional:   true,
+				Computed:   true,
+				Validators: separatorValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "The random ship name.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan plans the provider's default_separator when separator is unset.
+func (r *cultureShipClassResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	planDefaultSeparator(ctx, req, resp, r.providerData.defaultSeparator)
+}
+
+func (r *cultureShipClassResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan cultureShipClassModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every culture_ship attribute not configurable here is null, or its
+	// default, as if it were left unset on a culture_ship.
+	ships := &cultureShipResource{generator: r.generator, providerData: r.providerData}
+	generated, ok := ships.generate(ctx, cultureShipModelV2{
+		Case:            types.StringValue(caseLower),
+		Class:           types.StringValue(r.class),
+		IconicWeight:    types.Int64Value(spaceships.DefaultIconicWeight),
+		MarkdownBaseURL: types.StringValue(defaultMarkdownBaseURL),
+		MaxRetries:      types.Int64Value(defaultMaxRetries),
+		NameCount:       types.Int64Value(1),
+		Prefix:          plan.Prefix,
+		Separator:       plan.Separator,
+		Sort:            types.StringValue(sortNone),
+	}, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	sn := cultureShipClassModelV0{
+		ID:        generated.ID,
+		Keepers:   plan.Keepers,
+		Prefix:    generated.Prefix,
+		Separator: generated.Separator,
+	}
+
+	diags = resp.State.Set(ctx, sn)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *cultureShipClassResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+// Update ensures the plan value is copied to the state to complete the update.
+func (r *cultureShipClassResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model cultureShipClassModelV0
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *cultureShipClassResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+type cultureShipClassModelV0 struct {
+	ID        types.String `tfsdk:"id"`
+	Keepers   types.Map    `tfsdk:"keepers"`
+	Prefix    types.String `tfsdk:"prefix"`
+	Separator types.String `tfsdk:"separator"`
+}
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/matthewbaggett/terraform-provider-fun-names/internal/spaceships"
+)
+
+// inClass returns a check that the value, once any prefix is trimmed, is the
+// name of a known ship of the given class joined by the separator.
+func inClass(class, prefix, separator string) resource.CheckResourceAttrWithFunc {
+	return func(id string) error {
+		ship, ok := spaceships.Find(strings.TrimPrefix(id, prefix), separator)
+		if !ok {
+			return fmt.Errorf("expected %q to be a known ship", id)
+		}
+
+		if ship.Class != class {
+			return fmt.Errorf("expected %q to be a %s, got %q", id, class, ship.Class)
+		}
+
+		return nil
+	}
+}
+
+func TestAccResourceCultureGSV(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_gsv" "ship" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("fun-names_culture_gsv.ship", "id", regexp.MustCompile(`^[^A-Z ]+$`)),
+					resource.TestCheckResourceAttrWith("fun-names_culture_gsv.ship", "id", inClass(spaceships.ClassGSV, "", "-")),
+					resource.TestCheckResourceAttr("fun-names_culture_gsv.ship", "separator", "-"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureGCU_Prefix(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_gcu" "ship" {
+							prefix    = "contact"
+							separator = "_"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("fun-names_culture_gcu.ship", "id", regexp.MustCompile(`^contact_[^-]+$`)),
+					resource.TestCheckResourceAttrWith("fun-names_culture_gcu.ship", "id", inClass(spaceships.ClassGCU, "contact_", "_")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureGCU_Keepers(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_gcu" "ship" {
+							keepers = {
+								"key" = "123"
+							}
+						}`,
+				Check: resource.TestCheckResourceAttrSet("fun-names_culture_gcu.ship", "id"),
+			},
+			{
+				Config: `resource "fun-names_culture_gcu" "ship" {
+							keepers = {
+								"key" = "456"
+							}
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fun-names_culture_gcu.ship", plancheck.ResourceActionReplace),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureGSV_DefaultSeparator(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "fun-names" {
+							default_separator = "_"
+						}
+
+						resource "fun-names_culture_gsv" "ship" {
+							prefix = "gsv"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_gsv.ship", "separator", "_"),
+					resource.TestCheckResourceAttrWith("fun-names_culture_gsv.ship", "id", inClass(spaceships.ClassGSV, "gsv_", "_")),
+				),
+			},
+			{
+				// Existing resources keep their separator when the provider
+				// default changes.
+				Config: `provider "fun-names" {
+							default_separator = "."
+						}
+
+						resource "fun-names_culture_gsv" "ship" {
+							prefix = "gsv"
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fun-names_culture_gsv.ship", plancheck.ResourceActionNoop),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureGSV_SingleCharacterSeparator(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "fun-names" {
+							single_character_separator = true
+						}
+
+						resource "fun-names_culture_gsv" "ship" {
+							separator = "::"
+						}`,
+				ExpectError: regexp.MustCompile(`Invalid Separator`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureGCU_EnsureUnique(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		// The fake generator ignores the class, and draws the same ship twice
+		// before a different one.
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesWithShips("Twice Drawn Contact", "Twice Drawn Contact", "Drawn Once Contact"),
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "fun-names" {
+							ensure_unique = true
+						}
+
+						resource "fun-names_culture_gcu" "one" {}
+
+						resource "fun-names_culture_gcu" "two" {}`,
+				Check: func(s *terraform.State) error {
+					one := s.RootModule().Resources["fun-names_culture_gcu.one"].Primary.ID
+					two := s.RootModule().Resources["fun-names_culture_gcu.two"].Primary.ID
+					if one == two {
+						return fmt.Errorf("expected distinct names, both are %q", one)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ConfigValidator = prefixSeparatorValidator{}
+
+// prefixSeparatorValidator warns when the prefix contains the separator it is
+// joined to the ship name with, as the boundary between them is then
+// ambiguous. It only warns, so that existing configurations keep working.
+type prefixSeparatorValidator struct {
+	// defaultSeparator is the separator used when separator is not set.
+	defaultSeparator string
+}
+
+func (v prefixSeparatorValidator) Description(_ context.Context) string {
+	return "prefix should not contain the separator it is joined to the ship name with"
+}
+
+func (v prefixSeparatorValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v prefixSeparatorValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var prefix, separator, prefixSeparator types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("prefix"), &prefix)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("separator"), &separator)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("prefix_separator"), &prefixSeparator)...)
+	if resp.Diagnostics.HasError() || prefix.IsNull() || prefix.IsUnknown() {
+		return
+	}
+
+	if prefixSeparator.IsUnknown() || prefixSeparator.IsNull() && separator.IsUnknown() {
+		return
+	}
+
+	joinedBy := v.defaultSeparator
+	switch {
+	case !prefixSeparator.IsNull():
+		joinedBy = prefixSeparator.ValueString()
+	case !separator.IsNull():
+		joinedBy = separator.ValueString()
+	}
+
+	if joinedBy == "" {
+		return
+	}
+
+	if strings.Contains(prefix.ValueString(), joinedBy) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("prefix"),
+			"Prefix Contains Separator",
+			fmt.Sprintf("The prefix %q contains %q, the separator it is joined to the ship name with, so where "+
+				"the prefix ends and the ship name begins in id is ambiguous. Splitting the id back apart, for "+
+				"example when importing it, may then go wrong. Use a prefix without %q, or set prefix_separator "+
+				"to a separator the prefix does not contain.", prefix.ValueString(), joinedBy, joinedBy),
+		)
+	}
+}
+
+var _ resource.ConfigValidator = boundsValidator{}
+
+// boundsValidator checks that the attribute holding a lower bound is not
+// greater than the one holding the upper bound, so that the bounds can be
+// satisfied together.
+type boundsValidator struct {
+	// min and max are the names of the attributes holding the bounds.
+	min, max string
+	// unit is what the bounds count, such as "characters".
+	unit string
+}
+
+func (v boundsValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("%s must not be greater than %s", v.min, v.max)
+}
+
+func (v boundsValidator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("`%s` must not be greater than `%s`", v.min, v.max)
+}
+
+func (v boundsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var lower, upper types.Int64
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(v.min), &lower)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(v.max), &upper)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if lower.IsNull() || lower.IsUnknown() || upper.IsNull() || upper.IsUnknown() {
+		return
+	}
+
+	if lower.ValueInt64() > upper.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(v.min),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("%s = %d is greater than %s = %d, so no ship name can have both at least %d and at "+
+				"most %d %s. Lower %s or raise %s.", v.min, lower.ValueInt64(), v.max, upper.ValueInt64(),
+				lower.ValueInt64(), upper.ValueInt64(), v.unit, v.min, v.max),
+		)
+	}
+}
+
+var _ resource.ConfigValidator = includeExcludeValidator{}
+
+// includeExcludeValidator rejects names that are in both include_only and
+// exclude, as it is unclear whether they were meant to be generated.
+type includeExcludeValidator struct{}
+
+func (v includeExcludeValidator) Description(_ context.Context) string {
+	return "include_only and exclude must not both list the same ship name"
+}
+
+func (v includeExcludeValidator) MarkdownDescription(_ context.Context) string {
+	return "`include_only` and `exclude` must not both list the same ship name"
+}
+
+func (v includeExcludeValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var includeOnly, exclude types.List
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("include_only"), &includeOnly)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("exclude"), &exclude)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if includeOnly.IsNull() || includeOnly.IsUnknown() || exclude.IsNull() || exclude.IsUnknown() {
+		return
+	}
+
+	var include, excluded []types.String
+
+	resp.Diagnostics.Append(includeOnly.ElementsAs(ctx, &include, false)...)
+	resp.Diagnostics.Append(exclude.ElementsAs(ctx, &excluded, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Names are compared case-insensitively, as the filters compare them
+	excludedNames := make(map[string]struct{}, len(excluded))
+	for _, name := range excluded {
+		if !name.IsUnknown() && !name.IsNull() {
+			excludedNames[strings.ToLower(name.ValueString())] = struct{}{}
+		}
+	}
+
+	var overlap []string
+	covered := true
+	for _, name := range include {
+		if name.IsUnknown() {
+			covered = false
+			continue
+		}
+		if name.IsNull() {
+			continue
+		}
+
+		if _, ok := excludedNames[strings.ToLower(name.ValueString())]; ok {
+			overlap = append(overlap, strconv.Quote(name.ValueString()))
+		} else {
+			covered = false
+		}
+	}
+
+	if len(overlap) == 0 {
+		return
+	}
+
+	if covered {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("include_only"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("Every name in include_only is also in exclude, so no ship name can be generated: %s. "+
+				"Remove these names from exclude, or add names to include_only that are not excluded.",
+				strings.Join(overlap, ", ")),
+		)
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("include_only"),
+		"Invalid Attribute Combination",
+		fmt.Sprintf("The following names are in both include_only and exclude, so it is unclear whether they "+
+			"should be generated: %s. Remove them from one of the two lists.", strings.Join(overlap, ", ")),
+	)
+}
@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// cultureShipConfig returns a culture_ship configuration with the given
+// attribute values, leaving every other attribute null.
+func cultureShipConfig(t *testing.T, values map[string]tftypes.Value) tfsdk.Config {
+	t.Helper()
+
+	var resp resource.SchemaResponse
+	NewCultureShipResource().Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema error: %v", resp.Diagnostics)
+	}
+
+	typ := resp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	attributes := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for name, attributeType := range typ.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range values {
+		attributes[name] = value
+	}
+
+	return tfsdk.Config{
+		Schema: resp.Schema,
+		Raw:    tftypes.NewValue(typ, attributes),
+	}
+}
+
+func TestPrefixSeparatorValidator(t *testing.T) {
+	str := func(s string) tftypes.Value { return tftypes.NewValue(tftypes.String, s) }
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+
+	tests := map[string]struct {
+		defaultSeparator string
+		values           map[string]tftypes.Value
+		warn             bool
+	}{
+		"no prefix": {
+			defaultSeparator: "-",
+			values:           map[string]tftypes.Value{"separator": str("-")},
+		},
+		"prefix without separator": {
+			defaultSeparator: "-",
+			values:           map[string]tftypes.Value{"prefix": str("env")},
+		},
+		"prefix with default separator": {
+			defaultSeparator: "-",
+			values:           map[string]tftypes.Value{"prefix": str("my-env")},
+			warn:             true,
+		},
+		"prefix with provider default separator": {
+			defaultSeparator: "_",
+			values:           map[string]tftypes.Value{"prefix": str("my_env")},
+			warn:             true,
+		},
+		"prefix with other separator": {
+			defaultSeparator: "-",
+			values:           map[string]tftypes.Value{"prefix": str("my-env"), "separator": str("_")},
+		},
+		"prefix with separator": {
+			defaultSeparator: "-",
+			values:           map[string]tftypes.Value{"prefix": str("my_env"), "separator": str("_")},
+			warn:             true,
+		},
+		"prefix with prefix separator": {
+			defaultSeparator: "-",
+			values:           map[string]tftypes.Value{"prefix": str("my.env"), "prefix_separator": str(".")},
+			warn:             true,
+		},
+		"prefix separator overrides separator": {
+			defaultSeparator: "-",
+			values:           map[string]tftypes.Value{"prefix": str("my-env"), "prefix_separator": str(".")},
+		},
+		"empty separator": {
+			defaultSeparator: "-",
+			values:           map[string]tftypes.Value{"prefix": str("my-env"), "separator": str("")},
+		},
+		"unknown prefix": {
+			defaultSeparator: "-",
+			values:           map[string]tftypes.Value{"prefix": unknown},
+		},
+		"unknown separator": {
+			defaultSeparator: "-",
+			values:           map[string]tftypes.Value{"prefix": str("my-env"), "separator": unknown},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{Config: cultureShipConfig(t, tt.values)}
+			var resp resource.ValidateConfigResponse
+
+			prefixSeparatorValidator{defaultSeparator: tt.defaultSeparator}.ValidateResource(context.Background(), req, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tt.warn {
+				t.Errorf("expected a warning to be %t, got %v", tt.warn, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestBoundsValidator(t *testing.T) {
+	num := func(n int64) tftypes.Value { return tftypes.NewValue(tftypes.Number, n) }
+	unknown := tftypes.NewValue(tftypes.Number, tftypes.UnknownValue)
+
+	tests := map[string]struct {
+		values map[string]tftypes.Value
+		err    bool
+	}{
+		"neither":   {values: map[string]tftypes.Value{}},
+		"only min":  {values: map[string]tftypes.Value{"min_words": num(3)}},
+		"only max":  {values: map[string]tftypes.Value{"max_words": num(3)}},
+		"equal":     {values: map[string]tftypes.Value{"min_words": num(3), "max_words": num(3)}},
+		"ordered":   {values: map[string]tftypes.Value{"min_words": num(2), "max_words": num(3)}},
+		"reversed":  {values: map[string]tftypes.Value{"min_words": num(4), "max_words": num(3)}, err: true},
+		"unknown":   {values: map[string]tftypes.Value{"min_words": unknown, "max_words": num(3)}},
+		"unrelated": {values: map[string]tftypes.Value{"min_length": num(40), "max_words": num(3)}},
+	}
+
+	v := boundsValidator{min: "min_words", max: "max_words", unit: "words"}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{Config: cultureShipConfig(t, tt.values)}
+			var resp resource.ValidateConfigResponse
+
+			v.ValidateResource(context.Background(), req, &resp)
+
+			if got := resp.Diagnostics.HasError(); got != tt.err {
+				t.Errorf("expected an error to be %t, got %v", tt.err, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestIncludeExcludeValidator(t *testing.T) {
+	list := func(names ...string) tftypes.Value {
+		values := make([]tftypes.Value, len(names))
+		for i, name := range names {
+			values[i] = tftypes.NewValue(tftypes.String, name)
+		}
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, values)
+	}
+	unknown := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue)
+
+	tests := map[string]struct {
+		values map[string]tftypes.Value
+		want   string
+	}{
+		"neither": {values: map[string]tftypes.Value{}},
+		"only include_only": {
+			values: map[string]tftypes.Value{"include_only": list("Sleeper Service")},
+		},
+		"disjoint": {
+			values: map[string]tftypes.Value{"include_only": list("Sleeper Service"), "exclude": list("Grey Area")},
+		},
+		"overlap": {
+			values: map[string]tftypes.Value{
+				"include_only": list("Sleeper Service", "Grey Area"),
+				"exclude":      list("grey area"),
+			},
+			want: `in both include_only and exclude, so it is unclear whether they should be generated: "Grey Area"`,
+		},
+		"covered": {
+			values: map[string]tftypes.Value{
+				"include_only": list("Sleeper Service", "Grey Area"),
+				"exclude":      list("Grey Area", "Ablation", "SLEEPER SERVICE"),
+			},
+			want: `Every name in include_only is also in exclude, so no ship name can be generated: "Sleeper Service", "Grey Area"`,
+		},
+		"unknown": {
+			values: map[string]tftypes.Value{"include_only": list("Grey Area"), "exclude": unknown},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{Config: cultureShipConfig(t, tt.values)}
+			var resp resource.ValidateConfigResponse
+
+			includeExcludeValidator{}.ValidateResource(context.Background(), req, &resp)
+
+			if tt.want == "" {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected error: %v", resp.Diagnostics)
+				}
+				return
+			}
+
+			if resp.Diagnostics.ErrorsCount() != 1 {
+				t.Fatalf("expected one error, got %v", resp.Diagnostics)
+			}
+
+			if got := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(got, tt.want) {
+				t.Errorf("expected the error to contain %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
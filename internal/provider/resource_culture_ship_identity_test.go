@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCultureShipResource_ImportStateIdentity(t *testing.T) {
+	ctx := context.Background()
+	r := NewCultureShipResource().(*cultureShipResource)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	var identityResp resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+	if identityResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected identity schema error: %v", identityResp.Diagnostics)
+	}
+
+	identityType := identityResp.IdentitySchema.Type().TerraformType(ctx)
+	stateType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	req := resource.ImportStateRequest{
+		Identity: &tfsdk.ResourceIdentity{
+			Schema: identityResp.IdentitySchema,
+			Raw: tftypes.NewValue(identityType, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, "hub-Sleeper-Service"),
+			}),
+		},
+	}
+	resp := resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(stateType, nil),
+		},
+		Identity: &tfsdk.ResourceIdentity{
+			Schema: identityResp.IdentitySchema,
+			Raw:    tftypes.NewValue(identityType, nil),
+		},
+	}
+
+	r.ImportState(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected import error: %v", resp.Diagnostics)
+	}
+
+	for name, want := range map[string]string{"id": "hub-Sleeper-Service", "prefix": "hub", "name": "Sleeper-Service"} {
+		var got types.String
+		resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root(name), &got)...)
+		if got.ValueString() != want {
+			t.Errorf("expected %s %q, got %q", name, want, got.ValueString())
+		}
+	}
+
+	var identity cultureShipIdentityModel
+	resp.Diagnostics.Append(resp.Identity.Get(ctx, &identity)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected identity error: %v", resp.Diagnostics)
+	}
+	if identity.ID.ValueString() != "hub-Sleeper-Service" {
+		t.Errorf("expected identity id %q, got %q", "hub-Sleeper-Service", identity.ID.ValueString())
+	}
+}
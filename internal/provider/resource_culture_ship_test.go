@@ -0,0 +1,3325 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	res "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/matthewbaggett/terraform-provider-fun-names/internal/spaceships"
+)
+
+func TestAccResourceCultureShip_Prefix(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix = "gsv"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("fun-names_culture_ship.ship", "id", regexp.MustCompile(`^gsv-`)),
+					resource.TestCheckNoResourceAttr("fun-names_culture_ship.ship", "suffix"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_Length(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesWithShips("Sleeper Service"),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix = "gsv"
+							suffix = "prod"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "gsv-sleeper-service-prod"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "length", "24"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_Name(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesWithShips("Sleeper Service"),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix = "gsv"
+							suffix = "prod"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "gsv-sleeper-service-prod"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "name", "sleeper-service"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_Suffix(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							suffix = "prod"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("fun-names_culture_ship.ship", "id", regexp.MustCompile(`-prod$`)),
+					resource.TestCheckNoResourceAttr("fun-names_culture_ship.ship", "prefix"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_PrefixAndSuffix(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix    = "gsv"
+							suffix    = "prod"
+							separator = "_"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("fun-names_culture_ship.ship", "id", regexp.MustCompile(`^gsv_.+_prod$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_Case(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "case", "lower"),
+					resource.TestMatchResourceAttr("fun-names_culture_ship.ship", "id", regexp.MustCompile(`^[^A-Z]+$`)),
+				),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							case = "upper"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("fun-names_culture_ship.ship", "id", regexp.MustCompile(`^[^a-z]+$`)),
+				),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							case = "title"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("fun-names_culture_ship.ship", "id", regexp.MustCompile(`^[^a-z]`)),
+				),
+			},
+		},
+	})
+}
+
+// TestAccResourceCultureShip_CaseUnicode guards casing of letters outside
+// ASCII, which the catalogue of the books does not have yet.
+func TestAccResourceCultureShip_CaseUnicode(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesWithShips("Über Élan Of Ærø"),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "über-élan-of-ærø"),
+				),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							case = "upper"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "ÜBER-ÉLAN-OF-ÆRØ"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "slug", "über-élan-of-ærø"),
+				),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							case = "title"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "Über-Élan-of-Ærø"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "words.0", "Über"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "words.2", "of"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_CaseInvalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							case = "sarcastic"
+						}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_Seed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "one" {
+							seed = 42
+						}
+						resource "fun-names_culture_ship" "two" {
+							seed = 42
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.one", "seed", "42"),
+					resource.TestCheckResourceAttrPair("fun-names_culture_ship.one", "id", "fun-names_culture_ship.two", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_LengthBounds(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix     = "gsv"
+							min_length = 20
+							max_length = 30
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("fun-names_culture_ship.ship", "id", regexp.MustCompile(`^gsv-.{16,26}$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_LengthBoundsUnsatisfiable(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix     = "a-very-long-prefix"
+							max_length = 10
+						}`,
+				ExpectError: regexp.MustCompile(`Unsatisfiable Length Constraint`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_Class(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							class = "GSV"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "class", "GSV"),
+					resource.TestCheckResourceAttrSet("fun-names_culture_ship.ship", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_LengthBoundsReversed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							min_length = 20
+							max_length = 10
+						}`,
+				ExpectError: regexp.MustCompile(`min_length = 20 is greater than max_length = 10`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_WordBoundsReversed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							min_words = 4
+							max_words = 2
+						}`,
+				ExpectError: regexp.MustCompile(`min_words = 4 is greater than max_words = 2`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_ClassWithoutShips(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							class = "VFP"
+						}`,
+				ExpectError: regexp.MustCompile(`No Matching Ship Names`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_NameCount(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							name_count = 5
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "names.#", "5"),
+					resource.TestCheckResourceAttrPair("fun-names_culture_ship.ship", "names.0", "fun-names_culture_ship.ship", "id"),
+					testCheckResourceAttrListUnique("fun-names_culture_ship.ship", "names"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_NameCountTooLarge(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							name_count = 100000
+						}`,
+				ExpectError: regexp.MustCompile(`Too Many Names Requested`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_NameCountTooLargeForFilters(t *testing.T) {
+	gsv := spaceships.CountMatching(spaceships.InClass("GSV"))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				// There are enough ships in all, but not of the class.
+				Config: fmt.Sprintf(`resource "fun-names_culture_ship" "ship" {
+							class      = "GSV"
+							name_count = %d
+						}`, gsv+1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`only %d of the known ship names`, gsv)),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_PlanKnownValues(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "fixed" {
+							canonical_only = true
+							min_length     = 15
+							max_length     = 15
+						}
+
+						resource "fun-names_culture_ship" "open" {}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("fun-names_culture_ship.fixed", tfjsonpath.New("separator"), knownvalue.StringExact("-")),
+						plancheck.ExpectKnownValue("fun-names_culture_ship.fixed", tfjsonpath.New("catalogue_fingerprint"),
+							knownvalue.StringExact(catalogueFingerprint(spaceships.All()))),
+						plancheck.ExpectKnownValue("fun-names_culture_ship.fixed", tfjsonpath.New("is_canonical"), knownvalue.Bool(true)),
+						plancheck.ExpectKnownValue("fun-names_culture_ship.fixed", tfjsonpath.New("length"), knownvalue.Int64Exact(15)),
+						plancheck.ExpectUnknownValue("fun-names_culture_ship.fixed", tfjsonpath.New("id")),
+						plancheck.ExpectUnknownValue("fun-names_culture_ship.fixed", tfjsonpath.New("name")),
+						plancheck.ExpectUnknownValue("fun-names_culture_ship.open", tfjsonpath.New("id")),
+						plancheck.ExpectUnknownValue("fun-names_culture_ship.open", tfjsonpath.New("is_canonical")),
+						plancheck.ExpectUnknownValue("fun-names_culture_ship.open", tfjsonpath.New("length")),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.fixed", "length", "15"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.fixed", "is_canonical", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_MinDistance(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesWithShips("Grey Area", "Grey Arena", "Gray Area", "Sleeper Service"),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							name_count   = 2
+							min_distance = 3
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "min_distance", "3"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "names.#", "2"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "names.0", "grey-area"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "names.1", "sleeper-service"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_MinDistanceUnsatisfiable(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesWithShips("Grey Area", "Grey Arena", "Gray Area"),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							name_count   = 2
+							min_distance = 3
+							max_retries  = 10
+						}`,
+				ExpectError: regexp.MustCompile(`Only 1 ship names at least 3 edits apart were found after 11\s+attempts`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_MinDistanceInvalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							min_distance = 0
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute min_distance value must be at least 1`),
+			},
+		},
+	})
+}
+
+func testCheckResourceAttrListUnique(name, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes[key+".#"])
+		if err != nil {
+			return fmt.Errorf("unable to read %s length: %w", key, err)
+		}
+
+		seen := make(map[string]struct{}, count)
+		for i := 0; i < count; i++ {
+			v := rs.Primary.Attributes[fmt.Sprintf("%s.%d", key, i)]
+			if _, ok := seen[v]; ok {
+				return fmt.Errorf("duplicate value in %s: %q", key, v)
+			}
+			seen[v] = struct{}{}
+		}
+
+		return nil
+	}
+}
+
+func TestAccResourceCultureShip_Random(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				// With 212 known ships, five resources without a seed all
+				// drawing the same one would almost certainly mean that the
+				// names are not random.
+				Config: `resource "fun-names_culture_ship" "ship" {
+							count = 5
+						}`,
+				Check: func(s *terraform.State) error {
+					seen := make(map[string]struct{})
+					for i := 0; i < 5; i++ {
+						name := fmt.Sprintf("fun-names_culture_ship.ship.%d", i)
+						rs, ok := s.RootModule().Resources[name]
+						if !ok {
+							return fmt.Errorf("resource not found: %s", name)
+						}
+						seen[rs.Primary.ID] = struct{}{}
+					}
+
+					if len(seen) == 1 {
+						return fmt.Errorf("expected the resources to generate different names, got %d the same", 5)
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_EnsureUnique(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				// Without ensure_unique, identical seeds would produce identical names.
+				Config: `provider "fun-names" {
+							ensure_unique = true
+						}
+
+						resource "fun-names_culture_ship" "one" {
+							seed = 7
+						}
+
+						resource "fun-names_culture_ship" "two" {
+							seed = 7
+						}`,
+				Check: func(s *terraform.State) error {
+					one := s.RootModule().Resources["fun-names_culture_ship.one"].Primary.ID
+					two := s.RootModule().Resources["fun-names_culture_ship.two"].Primary.ID
+					if one == two {
+						return fmt.Errorf("expected distinct names, both are %q", one)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestCultureShipResource_EnsureUniqueReleasesFailedBatch(t *testing.T) {
+	generator, err := spaceships.NewListGenerator([]string{"Alpha Ship", "Beta Ship"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := &cultureShipResource{
+		generator:    generator,
+		providerData: providerData{registry: spaceships.NewRegistry()},
+	}
+
+	plan := cultureShipModelV2{
+		Case:       types.StringValue(caseOriginal),
+		MaxRetries: types.Int64Value(100),
+		NameCount:  types.Int64Value(2),
+		Separator:  types.StringValue("-"),
+		Sort:       types.StringValue(sortNone),
+	}
+
+	// No two names are this far apart, so the batch fails after the first
+	// name has been claimed
+	failing := plan
+	failing.MinDistance = types.Int64Value(100)
+
+	var diags diag.Diagnostics
+	if _, ok := r.generate(context.Background(), failing, &diags); ok {
+		t.Fatal("expected the batch to fail")
+	}
+
+	diags = nil
+	pn, ok := r.generate(context.Background(), plan, &diags)
+	if !ok {
+		t.Fatalf("expected the names of the failed batch to be released, got: %v", diags)
+	}
+	if got := len(pn.Names.Elements()); got != 2 {
+		t.Errorf("expected 2 names, got %d", got)
+	}
+}
+
+func TestAccResourceCultureShip_RecentWindow(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				// Without recent_window, identical seeds would produce identical names.
+				Config: `provider "fun-names" {
+							recent_window = 1
+						}
+
+						resource "fun-names_culture_ship" "one" {
+							seed = 7
+						}
+
+						resource "fun-names_culture_ship" "two" {
+							seed = 7
+						}`,
+				Check: func(s *terraform.State) error {
+					one := s.RootModule().Resources["fun-names_culture_ship.one"].Primary.ID
+					two := s.RootModule().Resources["fun-names_culture_ship.two"].Primary.ID
+					if one == two {
+						return fmt.Errorf("expected distinct names, both are %q", one)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestCultureShipResource_RecentWindowSkipsFailedBatch(t *testing.T) {
+	generator, err := spaceships.NewListGenerator([]string{"Alpha Ship", "Beta Ship"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := &cultureShipResource{
+		generator:    generator,
+		providerData: providerData{recent: spaceships.NewRecentWindow(2)},
+	}
+
+	plan := cultureShipModelV2{
+		Case:       types.StringValue(caseOriginal),
+		MaxRetries: types.Int64Value(100),
+		NameCount:  types.Int64Value(2),
+		Separator:  types.StringValue("-"),
+		Sort:       types.StringValue(sortNone),
+	}
+
+	// No two names are this far apart, so the batch fails after the first
+	// name has been chosen
+	failing := plan
+	failing.MinDistance = types.Int64Value(100)
+
+	var diags diag.Diagnostics
+	if _, ok := r.generate(context.Background(), failing, &diags); ok {
+		t.Fatal("expected the batch to fail")
+	}
+
+	diags = nil
+	pn, ok := r.generate(context.Background(), plan, &diags)
+	if !ok {
+		t.Fatalf("expected the names of the failed batch to stay out of the window, got: %v", diags)
+	}
+	if got := len(pn.Names.Elements()); got != 2 {
+		t.Errorf("expected 2 names, got %d", got)
+	}
+
+	var names []string
+	diags.Append(pn.Names.ElementsAs(context.Background(), &names, false)...)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	for _, name := range names {
+		if !r.providerData.recent.Contains(name) {
+			t.Errorf("expected %q to be admitted to the window", name)
+		}
+	}
+}
+
+func TestAccResourceCultureShip_RecentWindowNegative(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "fun-names" {
+							recent_window = -1
+						}
+
+						resource "fun-names_culture_ship" "ship" {}`,
+				ExpectError: regexp.MustCompile(`Attribute recent_window value must be at least 0`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_Initials(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesWithShips("Of Course I Still Love You", "Ablation"),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix = "gsv"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "initials", "OCISLY"),
+				),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix = "gsv"
+						}
+
+						resource "fun-names_culture_ship" "single" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "initials", "OCISLY"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.single", "initials", "A"),
+				),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix              = "gsv"
+							initials_max_length = 3
+						}
+
+						resource "fun-names_culture_ship" "single" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "initials", "OCI"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "gsv-of-course-i-still-love-you"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_KeepersRegenerateInPlace(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesWithShips("Sleeper Service", "Grey Area", "Of Course I Still Love You"),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							keepers_regenerate_in_place = true
+							keepers = {
+								ami = "one"
+							}
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "sleeper-service"),
+				),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							keepers_regenerate_in_place = true
+							keepers = {
+								ami = "two"
+							}
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fun-names_culture_ship.ship", plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue("fun-names_culture_ship.ship", tfjsonpath.New("id")),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "grey-area"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "name", "grey-area"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "names.0", "grey-area"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "initials", "GA"),
+				),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							keepers_regenerate_in_place = true
+							keepers = {
+								ami = "two"
+							}
+							keepers_list = ["three"]
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fun-names_culture_ship.ship", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "of-course-i-still-love-you"),
+				),
+			},
+			{
+				// Without keepers_regenerate_in_place, a keeper change replaces the resource.
+				Config: `resource "fun-names_culture_ship" "ship" {
+							keepers = {
+								ami = "four"
+							}
+							keepers_list = ["three"]
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fun-names_culture_ship.ship", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "sleeper-service"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_Reversed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesWithShips("Of Course I Still Love You", "Ablation"),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix             = "gsv"
+							separator          = " "
+							case               = "original"
+							in_place_separator = true
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "reversed", "You Love Still I Course Of"),
+				),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix             = "gsv"
+							separator          = "_"
+							case               = "original"
+							in_place_separator = true
+						}
+
+						resource "fun-names_culture_ship" "single" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "reversed", "You_Love_Still_I_Course_Of"),
+					resource.TestCheckNoResourceAttr("fun-names_culture_ship.single", "reversed"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_IncludeOnlyExcluded(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							include_only = ["Sleeper Service", "Grey Area"]
+							exclude      = ["Grey Area"]
+						}`,
+				ExpectError: regexp.MustCompile(`in\s+both\s+include_only\s+and\s+exclude`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_ReplaceOnCatalogueChange(t *testing.T) {
+	first := writeCatalogue(t, "first.txt", "Zephyr Of Doubt\n")
+	firstRewritten := writeCatalogue(t, "first-rewritten.txt", "\r\nZephyr  Of Doubt\r\n\r\nzephyr of doubt\r\n")
+	second := writeCatalogue(t, "second.txt", "Only Ship In Town\n")
+
+	config := func(catalogue string) string {
+		return fmt.Sprintf(`provider "fun-names" {
+							catalogue_path = %q
+						}
+
+						resource "fun-names_culture_ship" "replaced" {
+							replace_on_catalogue_change = true
+						}
+
+						resource "fun-names_culture_ship" "kept" {}`, catalogue)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config(first),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.replaced", "id", "zephyr-of-doubt"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.replaced", "catalogue_fingerprint",
+						catalogueFingerprint([]string{"Zephyr Of Doubt"})),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.kept", "id", "zephyr-of-doubt"),
+				),
+			},
+			{
+				// Reapplying the same catalogue changes nothing.
+				Config: config(first),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				// Nor does rewriting it with other line endings, blank lines
+				// and duplicates of its ships.
+				Config: config(firstRewritten),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: config(second),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fun-names_culture_ship.replaced", plancheck.ResourceActionReplace),
+						plancheck.ExpectResourceAction("fun-names_culture_ship.kept", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.replaced", "id", "only-ship-in-town"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.replaced", "catalogue_fingerprint",
+						catalogueFingerprint([]string{"Only Ship In Town"})),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.kept", "id", "zephyr-of-doubt"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.kept", "catalogue_fingerprint",
+						catalogueFingerprint([]string{"Zephyr Of Doubt"})),
+				),
+			},
+		},
+	})
+}
+
+// writeCatalogue writes a catalogue file for catalogue_path, returning its path.
+func writeCatalogue(t *testing.T, name, content string) string {
+	t.Helper()
+
+	filename := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(filename, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return filename
+}
+
+func TestAccResourceCultureShip_CataloguePath(t *testing.T) {
+	catalogue := writeCatalogue(t, "ships.txt", "Zephyr Of Doubt\n\n  Sleeper Service\r\n")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`provider "fun-names" {
+							catalogue_path = %q
+						}
+
+						resource "fun-names_culture_ship" "invented" {
+							regex = "Zephyr"
+						}
+
+						resource "fun-names_culture_ship" "known" {
+							class = "GSV"
+						}
+
+						resource "fun-names_culture_ship" "canonical" {
+							canonical_only = true
+						}
+
+						resource "fun-names_culture_gsv" "ship" {}`, catalogue),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.invented", "id", "zephyr-of-doubt"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.invented", "is_canonical", "false"),
+					resource.TestCheckNoResourceAttr("fun-names_culture_ship.invented", "class"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.known", "id", "sleeper-service"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.known", "source", "Excession"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.canonical", "id", "sleeper-service"),
+					resource.TestCheckResourceAttr("fun-names_culture_gsv.ship", "id", "sleeper-service"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_CataloguePathCanonicalOnly(t *testing.T) {
+	catalogue := writeCatalogue(t, "ships.txt", "Zephyr Of Doubt\n")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				// The message names canonical_only, the only filter that is set.
+				Config: fmt.Sprintf(`provider "fun-names" {
+							catalogue_path = %q
+						}
+
+						resource "fun-names_culture_ship" "ship" {
+							canonical_only = true
+						}`, catalogue),
+				ExpectError: regexp.MustCompile(`No Matching Ship Names(.|\n)*canonical_only`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_CataloguePathJSON(t *testing.T) {
+	catalogue := writeCatalogue(t, "ships.json", `["Only Ship In Town"]`)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`provider "fun-names" {
+							catalogue_path = %q
+						}
+
+						resource "fun-names_culture_ship" "ship" {
+							name_count = 1
+						}`, catalogue),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "only-ship-in-town"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_CataloguePathMissing(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`provider "fun-names" {
+							catalogue_path = %q
+						}
+
+						resource "fun-names_culture_ship" "ship" {}`, filepath.Join(t.TempDir(), "missing.txt")),
+				ExpectError: regexp.MustCompile(`Unable to Load Catalogue`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_CataloguePathEmpty(t *testing.T) {
+	catalogue := writeCatalogue(t, "ships.txt", "\n  \n")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`provider "fun-names" {
+							catalogue_path = %q
+						}
+
+						resource "fun-names_culture_ship" "ship" {}`, catalogue),
+				ExpectError: regexp.MustCompile(`Empty Catalogue`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_CataloguePathEmptyWithExtraNames(t *testing.T) {
+	catalogue := writeCatalogue(t, "ships.txt", "\n  \n")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`provider "fun-names" {
+							catalogue_path = %q
+							extra_names    = [" ", ""]
+						}
+
+						resource "fun-names_culture_ship" "ship" {}`, catalogue),
+				ExpectError: regexp.MustCompile(`nor extra_names contains any ship names`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_ExtraNamesBlank(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "fun-names" {
+							extra_names = [" "]
+						}
+
+						resource "fun-names_culture_ship" "ship" {
+							regex = "^Sleeper"
+						}`,
+				Check: resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "sleeper-service"),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_ExtraNames(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "fun-names" {
+							extra_names = ["Zephyr Of Doubt", "sleeper  service"]
+						}
+
+						resource "fun-names_culture_ship" "extra" {
+							regex = "Zephyr"
+						}
+
+						resource "fun-names_culture_ship" "known" {
+							regex = "^Sleeper"
+						}
+
+						resource "fun-names_culture_ship" "both" {
+							regex      = "^(Zephyr|Sleeper) "
+							name_count = 2
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.extra", "id", "zephyr-of-doubt"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.extra", "is_canonical", "false"),
+					resource.TestCheckNoResourceAttr("fun-names_culture_ship.extra", "class"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.known", "id", "sleeper-service"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.known", "class", "GSV"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.both", "names.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_ExtraNamesWithClass(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "fun-names" {
+							extra_names = ["Zephyr Of Doubt"]
+						}
+
+						resource "fun-names_culture_ship" "ship" {
+							class = "GSV"
+							regex = "Zephyr"
+						}`,
+				ExpectError: regexp.MustCompile(`No Matching Ship Names`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_ExtraNamesWithCataloguePath(t *testing.T) {
+	catalogue := writeCatalogue(t, "ships.txt", "Only Ship In Town\n")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`provider "fun-names" {
+							catalogue_path = %q
+							extra_names    = ["Zephyr Of Doubt", "ONLY SHIP IN TOWN"]
+						}
+
+						resource "fun-names_culture_ship" "ship" {
+							name_count = 2
+						}`, catalogue),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "names.#", "2"),
+					resource.TestCheckTypeSetElemAttr("fun-names_culture_ship.ship", "names.*", "only-ship-in-town"),
+					resource.TestCheckTypeSetElemAttr("fun-names_culture_ship.ship", "names.*", "zephyr-of-doubt"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_MultiCharacterSeparator(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix    = "gsv"
+							suffix    = "prod"
+							separator = "::"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("fun-names_culture_ship.ship", "id", regexp.MustCompile(`^gsv::[^ ]+::prod$`)),
+					resource.TestMatchResourceAttr("fun-names_culture_ship.ship", "name", regexp.MustCompile(`^[^ ]+$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_EmptySeparator(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix    = "gsv"
+							separator = ""
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("fun-names_culture_ship.ship", "id", regexp.MustCompile(`^gsv\S+$`)),
+					// The words are still counted, though nothing separates them
+					resource.TestCheckResourceAttrPair("fun-names_culture_ship.ship", "word_count", "fun-names_culture_ship.ship", "words.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_SeparatorInvalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							separator = "x"
+						}`,
+				ExpectError: regexp.MustCompile(`must not contain letters or digits`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_SeparatorTab(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							separator = "\t"
+						}`,
+				ExpectError: regexp.MustCompile(`Invalid Separator(.|\n)*"\\t"`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_SeparatorControlCharacter(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							separator = "\u0007"
+						}`,
+				ExpectError: regexp.MustCompile(`must not contain control characters or whitespace`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_PrefixSeparatorControlCharacter(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix           = "gsv"
+							prefix_separator = "\u0007"
+						}`,
+				ExpectError: regexp.MustCompile(`must not contain control characters or whitespace`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_DefaultSeparatorControlCharacter(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "fun-names" {
+							default_separator = "\u0007"
+						}
+
+						resource "fun-names_culture_ship" "ship" {}`,
+				ExpectError: regexp.MustCompile(`must not contain control characters or whitespace`),
+			},
+		},
+	})
+}
+
+func TestSeparatorValidators_OneErrorPerFault(t *testing.T) {
+	ctx := context.Background()
+
+	for _, separator := range []string{"\t", "\n", "\r", "\u0007", "\u00a0", "x"} {
+		var diags diag.Diagnostics
+		for _, v := range separatorValidators() {
+			resp := &validator.StringResponse{}
+			v.ValidateString(ctx, validator.StringRequest{
+				Path:        path.Root("separator"),
+				ConfigValue: types.StringValue(separator),
+			}, resp)
+			diags.Append(resp.Diagnostics...)
+		}
+
+		if diags.ErrorsCount() != 1 {
+			t.Errorf("expected 1 error for separator %q, got %d: %v", separator, diags.ErrorsCount(), diags)
+		}
+	}
+}
+
+func TestAccResourceCultureShip_SingleCharacterSeparator(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "fun-names" {
+							single_character_separator = true
+						}
+
+						resource "fun-names_culture_ship" "ship" {
+							separator = "__"
+						}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must be exactly one\s+character`),
+			},
+			{
+				Config: `provider "fun-names" {
+							single_character_separator = true
+						}
+
+						resource "fun-names_culture_ship" "ship" {
+							separator = "_"
+						}`,
+				Check: resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "separator", "_"),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_WordCount(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix    = "gsv"
+							separator = "_"
+						}`,
+				Check: func(s *terraform.State) error {
+					attributes := s.RootModule().Resources["fun-names_culture_ship.ship"].Primary.Attributes
+
+					want := strconv.Itoa(strings.Count(attributes["name"], "_") + 1)
+					if got := attributes["word_count"]; got != want {
+						return fmt.Errorf("expected word_count %s for name %q, got %s", want, attributes["name"], got)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_WordBounds(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							min_words  = 2
+							max_words  = 3
+							name_count = 10
+						}`,
+				Check: func(s *terraform.State) error {
+					attributes := s.RootModule().Resources["fun-names_culture_ship.ship"].Primary.Attributes
+
+					if n, _ := strconv.Atoi(attributes["word_count"]); n < 2 || n > 3 {
+						return fmt.Errorf("expected %q to have between 2 and 3 words, got %d", attributes["name"], n)
+					}
+					if got, want := attributes["words.#"], attributes["word_count"]; got != want {
+						return fmt.Errorf("expected %s words for name %q, got %s", want, attributes["name"], got)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_WordBoundsHyphenated(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							include_only = ["Resistance Is Character-Forming"]
+							min_words    = 3
+							max_words    = 3
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "resistance-is-character-forming"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "word_count", "3"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "words.#", "3"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "words.2", "character-forming"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_WordBoundsUnsatisfiable(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							min_words = 50
+						}`,
+				ExpectError: regexp.MustCompile(`No Matching Ship Names`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_Import(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix = "hms"
+						}`,
+			},
+			{
+				ResourceName:      "fun-names_culture_ship.ship",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  "fun-names_culture_ship.ship",
+				ImportState:   true,
+				ImportStateId: "not-a-known-ship",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					attributes := states[0].Attributes
+
+					if attributes["name"] != "not-a-known-ship" {
+						return fmt.Errorf("expected name %q, got %q", "not-a-known-ship", attributes["name"])
+					}
+					if prefix, ok := attributes["prefix"]; ok && prefix != "" {
+						return fmt.Errorf("expected no prefix, got %q", prefix)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_ImportSeparator(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix    = "hms"
+							separator = "_"
+						}`,
+			},
+			{
+				ResourceName:      "fun-names_culture_ship.ship",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  "fun-names_culture_ship.ship",
+				ImportState:   true,
+				ImportStateId: "hms sleeper service",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					attributes := states[0].Attributes
+
+					if attributes["separator"] != " " {
+						return fmt.Errorf("expected separator %q, got %q", " ", attributes["separator"])
+					}
+					if attributes["prefix"] != "hms" {
+						return fmt.Errorf("expected prefix %q, got %q", "hms", attributes["prefix"])
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_ImportHyphenatedWord(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		// The ship is only in the provider's catalogue, not in the books.
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesWithShips("Quite Character-Forming Indeed"),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {}`,
+			},
+			{
+				ResourceName:  "fun-names_culture_ship.ship",
+				ImportState:   true,
+				ImportStateId: "gsv-quite-character-forming-indeed",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					attributes := states[0].Attributes
+
+					for name, want := range map[string]string{
+						"initials":   "QCI",
+						"name":       "quite-character-forming-indeed",
+						"prefix":     "gsv",
+						"reversed":   "indeed-character-forming-quite",
+						"word_count": "3",
+						"words.#":    "3",
+						"words.1":    "character-forming",
+					} {
+						if got := attributes[name]; got != want {
+							return fmt.Errorf("expected %s %q, got %q", name, want, got)
+						}
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestDetectSeparator(t *testing.T) {
+	tests := map[string]string{
+		"sleeper-service":                 "-",
+		"gsv_sleeper_service":             "_",
+		"gsv sleeper service":             " ",
+		"resistance_is_character-forming": "_",
+		"not_a-known_ship":                "_",
+		"xenophobe":                       "-",
+	}
+
+	for id, want := range tests {
+		if got := detectSeparator(spaceships.CatalogueGenerator{}, id); got != want {
+			t.Errorf("expected separator %q for %q, got %q", want, id, got)
+		}
+	}
+}
+
+func TestApplyCase_Title(t *testing.T) {
+	tests := []struct {
+		name, separator, want string
+	}{
+		{"Of Course I Still Love You", " ", "Of Course I Still Love You"},
+		{"just-read-the-instructions", "-", "Just-Read-the-Instructions"},
+		{"A_SHIP_WITH_A_VIEW", "_", "A_Ship_With_a_View"},
+		{"Experiencing A Significant Gravitas Shortfall", " ", "Experiencing a Significant Gravitas Shortfall"},
+		{"Passing By And Thought I'd Drop In", " ", "Passing by and Thought I'd Drop In"},
+		{"Anticipation Of A New Lover's Arrival, The", " ", "Anticipation of a New Lover's Arrival, The"},
+		{"sense amid madness, wit amidst folly", " ", "Sense Amid Madness, Wit Amidst Folly"},
+		{"the::ends::of::invention", "::", "The::Ends::of::Invention"},
+		{"Sleeper Service", "", "Sleeper service"},
+		{"Ablation", "-", "Ablation"},
+	}
+
+	for _, tt := range tests {
+		if got := applyCase(tt.name, tt.separator, caseTitle); got != tt.want {
+			t.Errorf("expected %q joined by %q in title case to be %q, got %q", tt.name, tt.separator, tt.want, got)
+		}
+	}
+
+	words := applyCaseWords([]string{"Of", "Course", "I", "Still", "Love", "You"}, caseTitle)
+	if got, want := strings.Join(words, " "), "Of Course I Still Love You"; got != want {
+		t.Errorf("expected title case words %q, got %q", want, got)
+	}
+
+	words = applyCaseWords([]string{"Just", "Read", "The", "Instructions"}, caseTitle)
+	if got, want := strings.Join(words, " "), "Just Read the Instructions"; got != want {
+		t.Errorf("expected title case words %q, got %q", want, got)
+	}
+}
+
+func TestApplyCase_Unicode(t *testing.T) {
+	tests := []struct {
+		name, mode, want string
+	}{
+		{"Über Élan Of Ærø", caseLower, "über élan of ærø"},
+		{"über élan of ærø", caseUpper, "ÜBER ÉLAN OF ÆRØ"},
+		{"ÜBER ÉLAN OF ÆRØ", caseTitle, "Über Élan of Ærø"},
+		{"ǆemal čelik", caseTitle, "ǅemal Čelik"},
+	}
+
+	for _, tt := range tests {
+		if got := applyCase(tt.name, " ", tt.mode); got != tt.want {
+			t.Errorf("expected %q in %s case to be %q, got %q", tt.name, tt.mode, tt.want, got)
+		}
+
+		if got := detectCase(tt.want, " "); got != tt.mode {
+			t.Errorf("expected %q to be detected as %s case, got %s", tt.want, tt.mode, got)
+		}
+	}
+}
+
+func TestInitials(t *testing.T) {
+	tests := []struct {
+		words     []string
+		maxLength int64
+		want      string
+	}{
+		{[]string{"Of", "Course", "I", "Still", "Love", "You"}, 0, "OCISLY"},
+		{[]string{"Of", "Course", "I", "Still", "Love", "You"}, 3, "OCI"},
+		{[]string{"Of", "Course", "I", "Still", "Love", "You"}, 10, "OCISLY"},
+		{[]string{"Ablation"}, 0, "A"},
+		{[]string{"Thorough", "But...", "Unreliable"}, 0, "TBU"},
+		{[]string{"Boo!"}, 1, "B"},
+		{[]string{"just", "'read'", "the", "instructions"}, 0, "JRTI"},
+		{[]string{"über", "élan"}, 0, "ÜÉ"},
+		{[]string{"...", "Mistake"}, 0, "M"},
+		{nil, 0, ""},
+	}
+
+	for _, tt := range tests {
+		if got := initials(tt.words, tt.maxLength); got != tt.want {
+			t.Errorf("expected initials of %q up to %d to be %q, got %q", tt.words, tt.maxLength, tt.want, got)
+		}
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"", "Ablation", 8},
+		{"Ablation", "", 8},
+		{"Grey Area", "Grey Area", 0},
+		{"Grey Area", "Gray Area", 1},
+		{"Grey Area", "Grey Arena", 1},
+		{"kitten", "sitting", 3},
+		{"flaw", "lawn", 2},
+		{"Über", "Uber", 1},
+	}
+
+	for _, tt := range tests {
+		if got := levenshtein(tt.a, tt.b); got != tt.want {
+			t.Errorf("expected distance between %q and %q to be %d, got %d", tt.a, tt.b, tt.want, got)
+		}
+	}
+}
+
+func TestUpgradeCultureShipStateV0toV2(t *testing.T) {
+	ctx := context.Background()
+
+	server, err := providerserver.NewProtocol5WithError(New())()
+	if err != nil {
+		t.Fatalf("unexpected error creating provider server: %s", err)
+	}
+
+	resp, err := server.UpgradeResourceState(ctx, &tfprotov5.UpgradeResourceStateRequest{
+		TypeName: "fun-names_culture_ship",
+		Version:  0,
+		RawState: &tfprotov5.RawState{
+			JSON: []byte(`{"id":"hms-sleeper-service","keepers":null,"prefix":"hms","separator":"-"}`),
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error upgrading state: %s", err)
+	}
+	for _, diag := range resp.Diagnostics {
+		t.Errorf("unexpected diagnostic: %s: %s", diag.Summary, diag.Detail)
+	}
+
+	schemaResp := &res.SchemaResponse{}
+	NewCultureShipResource().Schema(ctx, res.SchemaRequest{}, schemaResp)
+
+	upgraded, err := resp.UpgradedState.Unmarshal(schemaResp.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		t.Fatalf("unexpected error unmarshalling upgraded state: %s", err)
+	}
+
+	expected := map[string]tftypes.Value{
+		"case":       tftypes.NewValue(tftypes.String, "lower"),
+		"class":      tftypes.NewValue(tftypes.String, "GSV"),
+		"id":         tftypes.NewValue(tftypes.String, "hms-sleeper-service"),
+		"length":     tftypes.NewValue(tftypes.Number, 19),
+		"name":       tftypes.NewValue(tftypes.String, "sleeper-service"),
+		"name_count": tftypes.NewValue(tftypes.Number, 1),
+		"prefix":     tftypes.NewValue(tftypes.String, "hms"),
+		"separator":  tftypes.NewValue(tftypes.String, "-"),
+		"suffix":     tftypes.NewValue(tftypes.String, nil),
+		"word_count": tftypes.NewValue(tftypes.Number, 2),
+		"names": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "hms-sleeper-service"),
+		}),
+	}
+
+	for name, want := range expected {
+		got, err := testTftypesValueAtPath(upgraded, tftypes.NewAttributePath().WithAttributeName(name))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !got.Equal(want) {
+			t.Errorf("expected %s to be %s, got %s", name, want, got)
+		}
+	}
+}
+
+func TestUpgradeCultureShipStateV1toV2(t *testing.T) {
+	ctx := context.Background()
+
+	server, err := providerserver.NewProtocol5WithError(New())()
+	if err != nil {
+		t.Fatalf("unexpected error creating provider server: %s", err)
+	}
+
+	resp, err := server.UpgradeResourceState(ctx, &tfprotov5.UpgradeResourceStateRequest{
+		TypeName: "fun-names_culture_ship",
+		Version:  1,
+		RawState: &tfprotov5.RawState{
+			JSON: []byte(`{"id":"hms-sleeper-service","keepers":{"key":"123"},"name":"sleeper-service",` +
+				`"prefix":"hms","separator":"-"}`),
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error upgrading state: %s", err)
+	}
+	for _, diag := range resp.Diagnostics {
+		t.Errorf("unexpected diagnostic: %s: %s", diag.Summary, diag.Detail)
+	}
+
+	schemaResp := &res.SchemaResponse{}
+	NewCultureShipResource().Schema(ctx, res.SchemaRequest{}, schemaResp)
+
+	upgraded, err := resp.UpgradedState.Unmarshal(schemaResp.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		t.Fatalf("unexpected error unmarshalling upgraded state: %s", err)
+	}
+
+	expected := map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, "hms-sleeper-service"),
+		"name": tftypes.NewValue(tftypes.String, "sleeper-service"),
+		"keepers": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"key": tftypes.NewValue(tftypes.String, "123"),
+		}),
+	}
+
+	for name, want := range expected {
+		got, err := testTftypesValueAtPath(upgraded, tftypes.NewAttributePath().WithAttributeName(name))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !got.Equal(want) {
+			t.Errorf("expected %s to be %s, got %s", name, want, got)
+		}
+	}
+}
+
+func TestAccResourceCultureShip_Regex(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							regex = "Gravitas"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("fun-names_culture_ship.ship", "id", regexp.MustCompile(`gravitas`)),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "regex", "Gravitas"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_RegexInvalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							regex = "Gravitas("
+						}`,
+				ExpectError: regexp.MustCompile(`Invalid Regular Expression`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_RegexWithoutShips(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							regex = "^Enterprise$"
+						}`,
+				ExpectError: regexp.MustCompile(`No Matching Ship Names`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_DefaultSeparator(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "fun-names" {
+							default_separator = "_"
+						}
+
+						resource "fun-names_culture_ship" "default" {
+							prefix = "gsv"
+						}
+
+						resource "fun-names_culture_ship" "explicit" {
+							prefix    = "gsv"
+							separator = "."
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.default", "separator", "_"),
+					resource.TestMatchResourceAttr("fun-names_culture_ship.default", "id", regexp.MustCompile(`^gsv_`)),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.explicit", "separator", "."),
+					resource.TestMatchResourceAttr("fun-names_culture_ship.explicit", "id", regexp.MustCompile(`^gsv\.`)),
+				),
+			},
+			{
+				// Existing resources keep their separator when the provider
+				// default changes.
+				Config: `provider "fun-names" {
+							default_separator = "+"
+						}
+
+						resource "fun-names_culture_ship" "default" {
+							prefix = "gsv"
+						}
+
+						resource "fun-names_culture_ship" "explicit" {
+							prefix    = "gsv"
+							separator = "."
+						}`,
+				PlanOnly: true,
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "default" {
+							prefix = "gsv"
+						}`,
+				Check: resource.TestCheckResourceAttr("fun-names_culture_ship.default", "separator", "_"),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_DefaultSeparatorUnset(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {}`,
+				Check:  resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "separator", "-"),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_ProviderSeed(t *testing.T) {
+	var ids []string
+
+	for i := 0; i < 2; i++ {
+		resource.UnitTest(t, resource.TestCase{
+			ProtoV5ProviderFactories: protoV5ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: `provider "fun-names" {
+								seed = 42
+							}
+
+							resource "fun-names_culture_ship" "ship" {
+								name_count = 5
+							}`,
+					Check: func(s *terraform.State) error {
+						ids = append(ids, s.RootModule().Resources["fun-names_culture_ship.ship"].Primary.Attributes["names.4"])
+						return nil
+					},
+				},
+			},
+		})
+	}
+
+	if len(ids) != 2 || ids[0] != ids[1] {
+		t.Errorf("expected the same names from the same provider seed, got %q", ids)
+	}
+}
+
+func TestAccResourceCultureShip_Exclude(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							class   = "GSV"
+							exclude = [
+								"Bora Horza Gobuchul",
+								"empiricist",
+								"LITTLE RASCAL",
+								"Of Course I Still Love You",
+								"Size Isn't Everything",
+								"So Much For Subtlety",
+								"The Ends Of Invention",
+							]
+						}`,
+				Check: resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "sleeper-service"),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_ExcludeEverything(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							class   = "GOU"
+							exclude = [
+								"Falling Outside the Normal Moral Constraints",
+								"Limiting Factor",
+							]
+						}`,
+				ExpectError: regexp.MustCompile(`No Matching Ship Names`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_IncludeOnly(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							include_only = [
+								"sleeper service",
+								"USS Enterprise",
+							]
+						}`,
+				// The unknown name only produces a warning.
+				Check: resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "sleeper-service"),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_IncludeOnlyUnknown(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							include_only = ["USS Enterprise"]
+						}`,
+				ExpectError: regexp.MustCompile(`No Matching Ship Names`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_CanonicalOnly(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {}`,
+				Check:  resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "canonical_only", "false"),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							canonical_only = true
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "canonical_only", "true"),
+					resource.TestCheckResourceAttrSet("fun-names_culture_ship.ship", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_PrefixSeparator(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix           = "env"
+							prefix_separator = "_"
+							separator        = "-"
+							include_only     = ["Sleeper Service"]
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "env_sleeper-service"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "name", "sleeper-service"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_SuffixSeparator(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix           = "env"
+							prefix_separator = "_"
+							suffix           = "01"
+							suffix_separator = "."
+							include_only     = ["Sleeper Service"]
+						}`,
+				Check: resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "env_sleeper-service.01"),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_NormalizeCase(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix = "PROD"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "normalize_case", "false"),
+					resource.TestMatchResourceAttr("fun-names_culture_ship.ship", "id", regexp.MustCompile(`^PROD-[^A-Z]+$`)),
+				),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix         = "PROD"
+							suffix         = "EU"
+							normalize_case = true
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("fun-names_culture_ship.ship", "id", regexp.MustCompile(`^prod-[^A-Z]+-eu$`)),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "prefix", "PROD"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_Slug(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix       = "gsv"
+							separator    = "::"
+							case         = "upper"
+							include_only = ["Funny, It Worked Last Time..."]
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "name", "FUNNY,::IT::WORKED::LAST::TIME..."),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "slug", "funny-it-worked-last-time"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_Source(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							include_only = ["Sleeper Service"]
+						}`,
+				Check: resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "source", "Excession"),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							include_only = ["Boo!"]
+						}`,
+				Check: resource.TestCheckNoResourceAttr("fun-names_culture_ship.ship", "source"),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_InPlaceSeparator(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix             = "env"
+							suffix             = "01"
+							separator          = "-"
+							include_only       = ["Of Course I Still Love You", "Sleeper Service"]
+							name_count         = 2
+							in_place_separator = true
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("fun-names_culture_ship.ship", "id", regexp.MustCompile(`^env-[a-z-]+-01$`)),
+				),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix             = "env"
+							suffix             = "01"
+							separator          = "_"
+							include_only       = ["Of Course I Still Love You", "Sleeper Service"]
+							name_count         = 2
+							in_place_separator = true
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fun-names_culture_ship.ship", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("fun-names_culture_ship.ship", "id", regexp.MustCompile(`^env_(of_course_i_still_love_you|sleeper_service)_01$`)),
+					resource.TestMatchResourceAttr("fun-names_culture_ship.ship", "names.1", regexp.MustCompile(`^env_(of_course_i_still_love_you|sleeper_service)_01$`)),
+					resource.TestMatchResourceAttr("fun-names_culture_ship.ship", "name", regexp.MustCompile(`^[a-z_]+$`)),
+				),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix             = "env"
+							suffix             = "01"
+							separator          = "::"
+							include_only       = ["Of Course I Still Love You", "Sleeper Service"]
+							name_count         = 2
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fun-names_culture_ship.ship", plancheck.ResourceActionReplace),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_InPlaceSeparatorHyphenated(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "lower" {
+							include_only       = ["Resistance Is Character-Forming"]
+							in_place_separator = true
+						}
+
+						resource "fun-names_culture_ship" "title" {
+							include_only       = ["Resistance Is Character-Forming", "Sleeper Service"]
+							name_count         = 2
+							sort               = "asc"
+							case               = "title"
+							in_place_separator = true
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.lower", "id", "resistance-is-character-forming"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.title", "names.0", "Resistance-Is-Character-Forming"),
+				),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "lower" {
+							include_only       = ["Resistance Is Character-Forming"]
+							separator          = "_"
+							in_place_separator = true
+						}
+
+						resource "fun-names_culture_ship" "title" {
+							include_only       = ["Resistance Is Character-Forming", "Sleeper Service"]
+							name_count         = 2
+							sort               = "asc"
+							case               = "title"
+							separator          = " "
+							in_place_separator = true
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fun-names_culture_ship.lower", plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction("fun-names_culture_ship.title", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.lower", "id", "resistance_is_character-forming"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.lower", "name", "resistance_is_character-forming"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.lower", "word_count", "3"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.title", "names.0", "Resistance Is Character-forming"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.title", "names.1", "Sleeper Service"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_InPlaceSeparatorExtraNames(t *testing.T) {
+	config := func(separator string) string {
+		return fmt.Sprintf(`provider "fun-names" {
+							extra_names = ["Quite Character-Forming Indeed", "Zephyr Of Doubt"]
+						}
+
+						resource "fun-names_culture_ship" "ship" {
+							regex              = "^(Quite|Zephyr) "
+							name_count         = 2
+							sort               = "asc"
+							separator          = %q
+							in_place_separator = true
+						}`, separator)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config("-"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "names.0", "quite-character-forming-indeed"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "names.1", "zephyr-of-doubt"),
+				),
+			},
+			{
+				// Every name keeps its hyphenated word whole, not only the
+				// first, though none of them is a ship from the books.
+				Config: config("_"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fun-names_culture_ship.ship", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "names.0", "quite_character-forming_indeed"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "names.1", "zephyr_of_doubt"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_ExplicitDefaultSeparator(t *testing.T) {
+	noop := resource.ConfigPlanChecks{
+		PreApply: []plancheck.PlanCheck{
+			plancheck.ExpectResourceAction("fun-names_culture_ship.ship", plancheck.ResourceActionNoop),
+		},
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "separator", "-"),
+				),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							separator = "-"
+						}`,
+				ConfigPlanChecks: noop,
+			},
+			{
+				Config:           `resource "fun-names_culture_ship" "ship" {}`,
+				ConfigPlanChecks: noop,
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_Decoration(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							include_only = ["Sleeper Service"]
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "decoration", "none"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "decorated", "sleeper-service"),
+				),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							include_only = ["Sleeper Service"]
+							decoration   = "brackets"
+						}`,
+				Check: resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "decorated", "[sleeper-service]"),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							include_only = ["Sleeper Service"]
+							decoration   = "quotes"
+						}`,
+				Check: resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "decorated", `"sleeper-service"`),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							include_only = ["Sleeper Service"]
+							decoration   = "gsv_prefix"
+						}`,
+				Check: resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "decorated", "GSV Sleeper Service"),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							include_only       = ["Sleeper Service"]
+							decoration         = "gsv_prefix"
+							separator          = "_"
+							in_place_separator = true
+						}`,
+				Check: resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "decorated", "GSV Sleeper Service"),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_DecorationUnknownClass(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesWithShips("Entirely Made Up"),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							decoration = "gsv_prefix"
+						}`,
+				Check: resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "decorated", "Entirely Made Up"),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_DecorationInvalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							decoration = "shouty"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute decoration value must be one of`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_Markdown(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							include_only = ["Sleeper Service"]
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "markdown_base_url", "https://theculture.fandom.com/wiki"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "markdown", "[sleeper-service](https://theculture.fandom.com/wiki/sleeper-service)"),
+				),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							include_only      = ["Sleeper Service"]
+							markdown_base_url = "https://example.com/ships/"
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fun-names_culture_ship.ship", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "markdown", "[sleeper-service](https://example.com/ships/sleeper-service)"),
+				),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							include_only       = ["Sleeper Service"]
+							markdown_base_url  = "https://example.com/ships"
+							separator          = " "
+							case               = "title"
+							in_place_separator = true
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "markdown", "[Sleeper Service](https://example.com/ships/Sleeper%20Service)"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_MetadataJSON(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							include_only       = ["Sleeper Service"]
+							prefix             = "env"
+							in_place_separator = true
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "metadata_json",
+						`{"name":"sleeper-service","class":"GSV","source":"Excession","word_count":2,"length":19}`),
+				),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							include_only       = ["Sleeper Service"]
+							prefix             = "env"
+							in_place_separator = true
+							separator          = "::"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "metadata_json",
+						`{"name":"sleeper::service","class":"GSV","source":"Excession","word_count":2,"length":21}`),
+				),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							include_only = ["Ablation"]
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "metadata_json",
+						`{"name":"ablation","class":null,"source":null,"word_count":1,"length":8}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_Index(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							count        = 3
+							index        = count.index + 1
+							include_only = ["Sleeper Service", "Grey Area", "Ablation"]
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship.0", "id", "grey-area"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship.1", "id", "sleeper-service"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship.2", "id", "ablation"),
+				),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							count        = 3
+							index        = count.index + 1
+							include_only = ["Sleeper Service", "Grey Area", "Ablation"]
+						}`,
+				PlanOnly: true,
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							count        = 1
+							index        = 2
+							name_count   = 2
+							seed         = 1
+							include_only = ["Sleeper Service", "Grey Area", "Ablation"]
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship.0", "names.0", "sleeper-service"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship.0", "names.1", "ablation"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_IndexInvalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							index = -1
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute index value must be at least 0`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_IsCanonical(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "is_canonical", "true"),
+				),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							canonical_only = true
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "is_canonical", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_IsCanonicalInvented(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesWithShips("Entirely Made Up"),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "entirely-made-up"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "is_canonical", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_EmptyCatalogue(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesWithShips(),
+		Steps: []resource.TestStep{
+			{
+				Config:      `resource "fun-names_culture_ship" "ship" {}`,
+				ExpectError: regexp.MustCompile(`The ship name generator returned an empty name`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_DedupePrefix(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "matching" {
+							prefix        = "JUST"
+							include_only  = ["Just Testing"]
+							dedupe_prefix = true
+						}
+
+						resource "fun-names_culture_ship" "not_matching" {
+							prefix        = "JUST"
+							include_only  = ["Sleeper Service"]
+							dedupe_prefix = true
+						}
+
+						resource "fun-names_culture_ship" "disabled" {
+							prefix       = "JUST"
+							include_only = ["Just Testing"]
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.matching", "id", "just-testing"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.not_matching", "id", "JUST-sleeper-service"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.disabled", "id", "JUST-just-testing"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_DedupePrefixInPlaceSeparator(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix             = "just"
+							include_only       = ["Just Testing"]
+							dedupe_prefix      = true
+							in_place_separator = true
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "just-testing"),
+				),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix             = "just"
+							include_only       = ["Just Testing"]
+							dedupe_prefix      = true
+							in_place_separator = true
+							separator          = "_"
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fun-names_culture_ship.ship", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "just_testing"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "names.0", "just_testing"),
+				),
+			},
+		},
+	})
+}
+
+// dnsLabelRegexp matches valid DNS labels, as defined by RFC 1123.
+var dnsLabelRegexp = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// testCheckResourceAttrListDNSLabels checks that every element of the list
+// attribute key of the named resource is a valid DNS label.
+func testCheckResourceAttrListDNSLabels(name, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes[key+".#"])
+		if err != nil {
+			return fmt.Errorf("unable to read %s length: %w", key, err)
+		}
+
+		for i := 0; i < count; i++ {
+			if v := rs.Primary.Attributes[fmt.Sprintf("%s.%d", key, i)]; !dnsLabelRegexp.MatchString(v) {
+				return fmt.Errorf("expected %s to hold DNS labels, got %q", key, v)
+			}
+		}
+
+		return nil
+	}
+}
+
+func TestAccResourceCultureShip_DNSSafe(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix         = "--Team Ünïcode!"
+							suffix         = "Prod_"
+							separator      = " "
+							case           = "title"
+							normalize_case = true
+							name_count     = 20
+							dns_safe       = true
+						}
+
+						resource "fun-names_culture_ship" "long" {
+							prefix     = "a-very-long-prefix-that-leaves-little-room-for"
+							name_count = 5
+							dns_safe   = true
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("fun-names_culture_ship.ship", "id", dnsLabelRegexp),
+					resource.TestMatchResourceAttr("fun-names_culture_ship.ship", "id", regexp.MustCompile(`^team-n-code-.+-prod$`)),
+					resource.TestMatchResourceAttr("fun-names_culture_ship.ship", "name", regexp.MustCompile(`^[A-Z]`)),
+					testCheckResourceAttrListDNSLabels("fun-names_culture_ship.ship", "names"),
+					resource.TestMatchResourceAttr("fun-names_culture_ship.long", "id", dnsLabelRegexp),
+					testCheckResourceAttrListDNSLabels("fun-names_culture_ship.long", "names"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_DNSSafeMinLength(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix     = "a-very-long-prefix-that-leaves-no-room-at-all"
+							min_length = 64
+							dns_safe   = true
+						}`,
+				ExpectError: regexp.MustCompile(`DNS labels are at\s+most 63\s+characters`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_DNSSafeInPlaceSeparator(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesWithShips("Sleeper Service"),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							separator          = "_"
+							in_place_separator = true
+							dns_safe           = true
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "sleeper-service"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "name", "sleeper_service"),
+				),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							separator          = "."
+							in_place_separator = true
+							dns_safe           = true
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fun-names_culture_ship.ship", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "sleeper-service"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "names.0", "sleeper-service"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "name", "sleeper.service"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_Variants(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesWithShips("Sleeper Service"),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix             = "gsv"
+							suffix             = "prod"
+							separator          = "."
+							suffix_separator   = "::"
+							case               = "upper"
+							in_place_separator = true
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "gsv.SLEEPER.SERVICE::prod"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "variants.%", "3"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "variants.dash", "gsv-SLEEPER-SERVICE-prod"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "variants.space", "gsv SLEEPER SERVICE prod"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "variants.underscore", "gsv_SLEEPER_SERVICE_prod"),
+				),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix             = "gsv"
+							suffix             = "prod"
+							separator          = "~"
+							suffix_separator   = "::"
+							case               = "upper"
+							in_place_separator = true
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fun-names_culture_ship.ship", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "gsv~SLEEPER~SERVICE::prod"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "variants.dash", "gsv-SLEEPER-SERVICE-prod"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_VariantsHyphenated(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesWithShips("Resistance Is Character-Forming"),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "variants.dash", "resistance-is-character-forming"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "variants.space", "resistance is character-forming"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "variants.underscore", "resistance_is_character-forming"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_Sort(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesWithShips("Sleeper Service", "Grey Area", "Of Course I Still Love You"),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							name_count = 3
+							sort       = "asc"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "sleeper-service"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "names.0", "grey-area"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "names.1", "of-course-i-still-love-you"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "names.2", "sleeper-service"),
+				),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							name_count = 3
+							sort       = "desc"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "sleeper-service"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "names.0", "sleeper-service"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "names.1", "of-course-i-still-love-you"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "names.2", "grey-area"),
+				),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							name_count = 3
+							sort       = "length"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "sleeper-service"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "names.0", "grey-area"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "names.1", "sleeper-service"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "names.2", "of-course-i-still-love-you"),
+				),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							name_count = 3
+							sort       = "none"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "sleeper-service"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "names.0", "sleeper-service"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "names.1", "grey-area"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "names.2", "of-course-i-still-love-you"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_SortInvalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							sort = "random"
+						}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_Attributes(t *testing.T) {
+	lowercaseWithPrefix := func(prefix string) resource.CheckResourceAttrWithFunc {
+		return func(id string) error {
+			if id != strings.ToLower(id) {
+				return fmt.Errorf("expected id %q to be lowercase", id)
+			}
+			if !strings.HasPrefix(id, prefix) {
+				return fmt.Errorf("expected id %q to start with %q", id, prefix)
+			}
+			return nil
+		}
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesWithShips("Sleeper Service", "Of Course I Still Love You", "Grey Area"),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix    = "gsv"
+							separator = "_"
+							keepers = {
+								"key" = "123"
+							}
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("fun-names_culture_ship.ship", "id", lowercaseWithPrefix("gsv_")),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "gsv_sleeper_service"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "name", "sleeper_service"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "names.#", "1"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "names.0", "gsv_sleeper_service"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "prefix", "gsv"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "separator", "_"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "length", "19"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "word_count", "2"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "keepers.key", "123"),
+				),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix    = "gsv"
+							separator = "_"
+							keepers = {
+								"key" = "123"
+							}
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix    = "gsv"
+							separator = "_"
+							keepers = {
+								"key" = "456"
+							}
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fun-names_culture_ship.ship", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("fun-names_culture_ship.ship", "id", lowercaseWithPrefix("gsv_")),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "gsv_of_course_i_still_love_you"),
+				),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix    = "gcu"
+							separator = "."
+							keepers = {
+								"key" = "456"
+							}
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fun-names_culture_ship.ship", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("fun-names_culture_ship.ship", "id", lowercaseWithPrefix("gcu.")),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "id", "gcu.grey.area"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "word_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_Phonetic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesWithShips("Grey Area"),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix    = "gsv1"
+							separator = "_"
+						}`,
+				Check: resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "phonetic",
+					"Golf Sierra Victor One underscore Golf Romeo Echo Yankee underscore Alpha Romeo Echo Alpha"),
+			},
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix             = "gsv1"
+							separator          = "-"
+							in_place_separator = true
+						}`,
+				Check: resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "phonetic",
+					"Golf Sierra Victor One dash Golf Romeo Echo Yankee dash Alpha Romeo Echo Alpha"),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_Hash(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesWithShips("Grey Area"),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							prefix    = "gsv1"
+							separator = "_"
+						}`,
+				Check: resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "hash", "c4a98cf8"),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_FavorIconic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							favor_iconic = true
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "favor_iconic", "true"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "iconic_weight", "3"),
+					resource.TestCheckResourceAttrSet("fun-names_culture_ship.ship", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_IconicWeightInvalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							favor_iconic  = true
+							iconic_weight = 0
+						}`,
+				ExpectError: regexp.MustCompile(`must be at least 1`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_Words(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							separator    = "-"
+							case         = "original"
+							include_only = ["Resistance Is Character-Forming"]
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "name", "Resistance-Is-Character-Forming"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "words.#", "3"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "words.0", "Resistance"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "words.1", "Is"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "words.2", "Character-Forming"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_MaxRetries(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactoriesWithShips("Grey Area"),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							min_length  = 20
+							max_retries = 5
+						}`,
+				ExpectError: regexp.MustCompile(`found after 5\s+attempts`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_MaxRetriesInvalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_culture_ship" "ship" {
+							max_retries = 0
+						}`,
+				ExpectError: regexp.MustCompile(`must be at least 1`),
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_NumericInvalid(t *testing.T) {
+	tests := []struct {
+		attribute string
+		value     int
+		want      string
+	}{
+		{"min_length", -1, "at least 0"},
+		{"max_length", 0, "at least 1"},
+		{"name_count", 0, "at least 1"},
+		{"min_words", -1, "at least 0"},
+		{"max_words", 0, "at least 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.attribute, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`resource "fun-names_culture_ship" "ship" {
+									%s = %d
+								}`, tt.attribute, tt.value),
+						ExpectError: regexp.MustCompile(fmt.Sprintf(`Attribute %s value must be %s`, tt.attribute, tt.want)),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccResourceCultureShip_KeepersList(t *testing.T) {
+	config := func(keepers string) string {
+		return fmt.Sprintf(`resource "fun-names_culture_ship" "ship" {
+							keepers_list = %s
+						}`, keepers)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config(`["a", "b"]`),
+				Check:  resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "keepers_list.#", "2"),
+			},
+			{
+				Config: config(`["a", "b"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: config(`["a", "c"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fun-names_culture_ship.ship", plancheck.ResourceActionReplace),
+					},
+				},
+			},
+			{
+				Config: config(`["a", "c", "d"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fun-names_culture_ship.ship", plancheck.ResourceActionReplace),
+					},
+				},
+			},
+			{
+				Config: config(`["c", "a", "d"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fun-names_culture_ship.ship", plancheck.ResourceActionReplace),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceCultureShip_TypedKeepers(t *testing.T) {
+	config := func(flag bool, number int) string {
+		return fmt.Sprintf(`resource "fun-names_culture_ship" "ship" {
+							keepers = {
+								flag   = %t
+								number = %d
+							}
+						}`, flag, number)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config(true, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "keepers.flag", "true"),
+					resource.TestCheckResourceAttr("fun-names_culture_ship.ship", "keepers.number", "1"),
+				),
+			},
+			{
+				Config: config(true, 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: config(false, 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fun-names_culture_ship.ship", plancheck.ResourceActionReplace),
+					},
+				},
+			},
+			{
+				Config: config(false, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fun-names_culture_ship.ship", plancheck.ResourceActionReplace),
+					},
+				},
+			},
+		},
+	})
+}
+
+// BenchmarkCultureShipResource_NameCount50k generates 50,000 distinct names
+// in one resource, from a catalogue twice that size, to show that the batch
+// grows linearly with name_count. The filtered cases draw every ship of the
+// books from the same large catalogue, weighted or by index, to show that
+// the catalogue is not filtered again for each name.
+// logEntry returns the first entry of the tflogtest output in buf with the
+// given message, failing the test if there is none.
+func logEntry(t *testing.T, buf *bytes.Buffer, message string) map[string]interface{} {
+	t.Helper()
+
+	entries, err := tflogtest.MultilineJSONDecode(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, entry := range entries {
+		if entry["@message"] == message {
+			return entry
+		}
+	}
+
+	t.Fatalf("expected a log entry %q, got: %v", message, entries)
+	return nil
+}
+
+func TestCultureShipResource_ConstrainedTimingLog(t *testing.T) {
+	generator, err := spaceships.NewListGenerator([]string{"Sleeper Service", "Zephyr Of Doubt"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := &cultureShipResource{generator: generator}
+
+	var buf bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &buf)
+
+	var diags diag.Diagnostics
+	_, ok := r.generate(ctx, cultureShipModelV2{
+		Case:       types.StringValue(caseOriginal),
+		MaxRetries: types.Int64Value(defaultMaxRetries),
+		NameCount:  types.Int64Value(1),
+		Regex:      types.StringValue("^Sleeper"),
+		Separator:  types.StringValue("-"),
+		Sort:       types.StringValue(sortNone),
+	}, &diags)
+	if !ok {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	entry := logEntry(t, &buf, "Generated culture ship names under constraints")
+	if entry["@level"] != "debug" {
+		t.Errorf("expected a debug entry, got %v", entry["@level"])
+	}
+	if attempts, _ := entry["attempts"].(float64); attempts < 1 {
+		t.Errorf("expected at least one attempt, got %v", entry["attempts"])
+	}
+	if elapsed, _ := entry["elapsed"].(string); elapsed == "" {
+		t.Errorf("expected the elapsed time, got %v", entry["elapsed"])
+	}
+	if entry["name_count"] != float64(1) {
+		t.Errorf("expected name_count 1, got %v", entry["name_count"])
+	}
+}
+
+func TestCultureShipResource_EffectiveConfigurationLog(t *testing.T) {
+	generator, err := spaceships.NewListGenerator([]string{"Sleeper Service", "Zephyr Of Doubt"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := &cultureShipResource{generator: generator}
+
+	var buf bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &buf)
+
+	var diags diag.Diagnostics
+	_, ok := r.generate(ctx, cultureShipModelV2{
+		Case:          types.StringValue(caseLower),
+		CanonicalOnly: types.BoolValue(true),
+		MaxRetries:    types.Int64Value(defaultMaxRetries),
+		NameCount:     types.Int64Value(1),
+		Seed:          types.Int64Value(7),
+		Separator:     types.StringValue("_"),
+		Sort:          types.StringValue(sortNone),
+	}, &diags)
+	if !ok {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	entry := logEntry(t, &buf, "Effective culture ship configuration")
+	if entry["@level"] != "debug" {
+		t.Errorf("expected a debug entry, got %v", entry["@level"])
+	}
+
+	for key, want := range map[string]interface{}{
+		"case":         caseLower,
+		"favor_iconic": false,
+		"filters":      []interface{}{"canonical_only", "seed"},
+		"name_count":   float64(1),
+		"pool_size":    float64(1),
+		"separator":    "_",
+	} {
+		if got := entry[key]; fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("expected %s %v, got %v", key, want, got)
+		}
+	}
+}
+
+func BenchmarkCultureShipResource_NameCount50k(b *testing.B) {
+	const nameCount = 50000
+
+	names := make([]string, 0, 2*nameCount)
+	for i := 0; i < 2*nameCount; i++ {
+		names = append(names, fmt.Sprintf("Ship Number %d", i))
+	}
+	names = append(names, spaceships.All()...)
+
+	generator, err := spaceships.NewListGenerator(names)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	canonical := int64(spaceships.Count())
+
+	for name, plan := range map[string]cultureShipModelV2{
+		"unfiltered": {
+			NameCount: types.Int64Value(nameCount),
+		},
+		"canonical_only_weighted": {
+			CanonicalOnly: types.BoolValue(true),
+			FavorIconic:   types.BoolValue(true),
+			IconicWeight:  types.Int64Value(spaceships.DefaultIconicWeight),
+			NameCount:     types.Int64Value(canonical),
+		},
+		"canonical_only_index": {
+			CanonicalOnly: types.BoolValue(true),
+			Index:         types.Int64Value(0),
+			NameCount:     types.Int64Value(canonical),
+		},
+	} {
+		plan.Case = types.StringValue(caseOriginal)
+		plan.MaxRetries = types.Int64Value(nameCount)
+		plan.Separator = types.StringValue("-")
+		plan.Sort = types.StringValue(sortNone)
+
+		b.Run(name, func(b *testing.B) {
+			r := &cultureShipResource{generator: generator}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				var diags diag.Diagnostics
+
+				pn, ok := r.generate(context.Background(), plan, &diags)
+				if !ok {
+					b.Fatalf("unexpected error: %v", diags)
+				}
+
+				if got := int64(len(pn.Names.Elements())); got != plan.NameCount.ValueInt64() {
+					b.Fatalf("expected %d names, got %d", plan.NameCount.ValueInt64(), got)
+				}
+			}
+		})
+	}
+}
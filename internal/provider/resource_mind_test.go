@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceMind(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_mind" "mind" {
+							prefix    = "hub"
+							separator = "."
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("fun-names_mind.mind", "id", regexp.MustCompile(`^hub\.[^A-Z ]+$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceMind_SeparatorLineBreak(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "fun-names_mind" "mind" {
+							separator = "\r\n"
+						}`,
+				ExpectError: regexp.MustCompile(`Invalid Separator`),
+			},
+		},
+	})
+}
@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/matthewbaggett/terraform-provider-fun-names/internal/characters"
+	"github.com/matthewbaggett/terraform-provider-fun-names/internal/drones"
+	"github.com/matthewbaggett/terraform-provider-fun-names/internal/minds"
+	"github.com/matthewbaggett/terraform-provider-fun-names/internal/orbitals"
+	mapplanmodifiers "github.com/matthewbaggett/terraform-provider-fun-names/internal/planmodifiers/map"
+)
+
+var (
+	_ resource.Resource              = (*nameListResource)(nil)
+	_ resource.ResourceWithConfigure = (*nameListResource)(nil)
+)
+
+// NewCultureDroneResource returns the culture_drone resource, which generates
+// names of drones.
+func NewCultureDroneResource() resource.Resource {
+	return &nameListResource{
+		typeName: "culture_drone",
+		noun:     "drone",
+		description: "The resource `culture_drone` returns a name of a drone from the Culture Series by Ian M Banks\n" +
+			"\n" +
+			"It is much like the `culture_ship` resource, but draws from the drones of the books instead of the ships.\n",
+		generate:         drones.Generate,
+		generateWithRand: drones.GenerateWithRand,
+	}
+}
+
+// NewCultureCharacterResource returns the culture_character resource, which
+// generates names of characters, along with their first and last parts.
+func NewCultureCharacterResource() resource.Resource {
+	return &nameListResource{
+		typeName: "culture_character",
+		noun:     "character",
+		description: "The resource `culture_character` returns the name of a character from the Culture Series by Ian M Banks\n" +
+			"\n" +
+			"It is much like the `culture_ship` resource, but draws from the characters of the books instead of the ships.\n",
+		generate: func(string) string {
+			return characters.Generate()
+		},
+		generateWithRand: func(_ string, rnd *rand.Rand) string {
+			return characters.GenerateWithRand(rnd)
+		},
+		nameParts: true,
+	}
+}
+
+// NewOrbitalResource returns the orbital resource, which generates names of
+// Orbitals.
+func NewOrbitalResource() resource.Resource {
+	return &nameListResource{
+		typeName: "orbital",
+		noun:     "orbital",
+		description: "The resource `orbital` returns a name of an Orbital from the Culture Series by Ian M Banks\n" +
+			"\n" +
+			"It is much like the `culture_ship` resource, but draws from the Orbitals of the books instead of the ships.\n",
+		generate:         orbitals.Generate,
+		generateWithRand: orbitals.GenerateWithRand,
+	}
+}
+
+// NewMindResource returns the mind resource, which generates names of Minds.
+func NewMindResource() resource.Resource {
+	return &nameListResource{
+		typeName: "mind",
+		noun:     "Mind",
+		description: "The resource `mind` returns a name of a Mind from the Culture Series by Ian M Banks\n" +
+			"\n" +
+			"It is much like the `culture_ship` resource, but draws from the Minds of the books, such as the hubs of Orbitals, instead of the ships.\n",
+		generate:         minds.Generate,
+		generateWithRand: minds.GenerateWithRand,
+	}
+}
+
+// nameListResource generates names drawn from one of the lists of the books
+// other than the ships, with only the basic prefix, separator and keepers
+// attributes.
+type nameListResource struct {
+	// typeName is the name of the resource, without the provider's prefix.
+	typeName string
+	// noun is what the names are of, for documentation.
+	noun        string
+	description string
+	// generate and generateWithRand return a name with its words joined by
+	// the separator, from the global source of randomness or the given one.
+	// Create asks for spaces, and joins the words with the separator itself.
+	generate         func(separator string) string
+	generateWithRand func(separator string, rnd *rand.Rand) string
+	// nameParts adds the first_name and last_name attributes, holding the
+	// first and last words of the name as written in the books.
+	nameParts bool
+
+	providerData providerData
+}
+
+func (r *nameListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = *data
+}
+
+func (r *nameListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.typeName
+}
+
+func (r *nameListResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: r.description,
+		Attributes: map[string]schema.Attribute{
+			"keepers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifiers.RequiresReplaceIfValuesNotNull(),
+				},
+			},
+			"prefix": schema.StringAttribute{
+				Description: "A string to prefix the name with.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"separator": schema.StringAttribute{
+				Description: fmt.Sprintf("The character to separate words in the %s name. Defaults to \"-\"", r.noun),
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("-"),
+				Validators:  separatorValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: fmt.Sprintf("The random %s name.", r.noun),
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+
+	if r.nameParts {
+		resp.Schema.Attributes["first_name"] = schema.StringAttribute{
+			Description: fmt.Sprintf("The first part of the %s name, as written in the books.", r.noun),
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+		resp.Schema.Attributes["last_name"] = schema.StringAttribute{
+			Description: fmt.Sprintf("The last part of the %s name, as written in the books.", r.noun),
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+}
+
+func (r *nameListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// The attributes depend on nameParts, so they are read and written one at
+	// a time rather than through a model
+	var prefix, separator types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("prefix"), &prefix)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("separator"), &separator)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.providerData.checkSeparator(separator.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	var generated string
+	if rnd := r.providerData.newRand(); rnd != nil {
+		generated = r.generateWithRand(" ", rnd)
+	} else {
+		generated = r.generate(" ")
+	}
+
+	words := strings.Fields(generated)
+	name := strings.ToLower(strings.Join(words, separator.ValueString()))
+
+	// The state is the plan with the computed attributes set on it
+	resp.State.Raw = req.Plan.Raw
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"),
+		types.StringValue(composeID(prefix.ValueString(), name, "", separator.ValueString())))...)
+	if r.nameParts {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("first_name"), types.StringValue(words[0]))...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("last_name"), types.StringValue(words[len(words)-1]))...)
+	}
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *nameListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+// Update ensures the plan value is copied to the state to complete the update.
+func (r *nameListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.State.Raw = req.Plan.Raw
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *nameListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
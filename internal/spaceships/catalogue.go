@@ -0,0 +1,191 @@
+package spaceships
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// catalogue is a list of ships in the form generation works with. The
+// built-in catalogue is built from cultureShips exactly once, on first use;
+// others are built by NewListGenerator, or by subset for a single batch of
+// draws. Apart from its cache of weights, which is locked, a catalogue is only
+// read once built, so it is shared by concurrent callers.
+type catalogue struct {
+	// ships holds every known ship, in the order of cultureShips.
+	ships []catalogueShip
+	// names holds the name of every known ship, deduplicated and sorted.
+	names []string
+	// distinct holds the first of the ships of each name, sorted by name, for
+	// GenerateAt.
+	distinct []catalogueShip
+	// canonical holds the name of every ship in the catalogue. Only that of
+	// the built-in catalogue is consulted, by Canonical and ship, as only its
+	// ships are attested in the books.
+	canonical map[string]struct{}
+	// keys holds the matchKey of every known ship, for Contains.
+	keys map[string]struct{}
+	// classOf holds the class of every known ship of a known class, by
+	// matchKey, for ClassOf.
+	classOf map[string]string
+	// classes holds the ships of each class, in the order of cultureShips,
+	// so that drawing a ship of a class does not filter the whole catalogue.
+	classes map[string][]catalogueShip
+
+	// weights caches the cumulative weights of ships for the iconic weight
+	// last drawn with, as consecutive draws from a catalogue usually share
+	// it. It is the only part of a catalogue written after it is built.
+	weights struct {
+		sync.Mutex
+		iconicWeight int
+		cumulative   []int
+	}
+}
+
+type catalogueShip struct {
+	name   string
+	words  []string
+	class  string
+	source string
+	iconic bool
+	// tierWeight is the weight of the ship's editorial tier, for
+	// GenerateByTier.
+	tierWeight int
+}
+
+var (
+	catalogueOnce sync.Once
+	loaded        *catalogue
+)
+
+// ships returns the catalogue, building it on the first call.
+func ships() *catalogue {
+	catalogueOnce.Do(func() {
+		loaded = newCatalogue(cultureShips[:])
+	})
+	return loaded
+}
+
+// newCatalogue builds a catalogue of the given ship names. Ships whose names
+// are also in the built-in catalogue take their class and source from it.
+func newCatalogue(cultureShips []string) *catalogue {
+	iconic := make(map[string]struct{}, len(iconicShips))
+	for _, cultureShip := range iconicShips {
+		iconic[cultureShip] = struct{}{}
+	}
+
+	ships := make([]catalogueShip, 0, len(cultureShips))
+	for _, cultureShip := range cultureShips {
+		w := words(cultureShip)
+		// Store the name with its whitespace normalised, so that the name
+		// filters see agrees with the words it is joined from
+		name := strings.Join(w, " ")
+		_, isIconic := iconic[name]
+
+		ships = append(ships, catalogueShip{
+			name:       name,
+			words:      w,
+			class:      cultureShipClasses[name],
+			source:     cultureShipSources[name],
+			iconic:     isIconic,
+			tierWeight: tierWeight(isIconic, cultureShipSources[name]),
+		})
+	}
+
+	return indexCatalogue(ships)
+}
+
+// indexCatalogue builds the catalogue of the given ships, which it keeps.
+func indexCatalogue(ships []catalogueShip) *catalogue {
+	c := &catalogue{
+		ships:     ships,
+		names:     make([]string, 0, len(ships)),
+		distinct:  make([]catalogueShip, 0, len(ships)),
+		canonical: make(map[string]struct{}, len(ships)),
+		keys:      make(map[string]struct{}, len(ships)),
+		classOf:   make(map[string]string, len(ships)),
+		classes:   make(map[string][]catalogueShip, len(shipClasses)),
+	}
+
+	for _, s := range ships {
+		if s.class != "" {
+			c.classes[s.class] = append(c.classes[s.class], s)
+			c.classOf[matchKey(s.name)] = s.class
+		}
+
+		if _, ok := c.canonical[s.name]; !ok {
+			c.names = append(c.names, s.name)
+			c.distinct = append(c.distinct, s)
+		}
+		c.canonical[s.name] = struct{}{}
+		c.keys[matchKey(s.name)] = struct{}{}
+	}
+
+	sort.Strings(c.names)
+	sort.Slice(c.distinct, func(i, j int) bool {
+		return c.distinct[i].name < c.distinct[j].name
+	})
+	return c
+}
+
+// subset returns the catalogue of the ships accepted by every filter, so
+// that many ships can be drawn from them without filtering each time.
+// Without filters, this is the catalogue itself.
+func (c *catalogue) subset(filters []Filter) *catalogue {
+	if len(filters) == 0 {
+		return c
+	}
+
+	return indexCatalogue(c.matching(filters))
+}
+
+// join returns the ship's name with its words joined by the separator.
+func (s catalogueShip) join(separator string) string {
+	return strings.Join(s.words, separator)
+}
+
+// length returns the length of the ship's name with its words joined by the
+// separator, without joining them.
+func (s catalogueShip) length(separator string) int {
+	n := (len(s.words) - 1) * len(separator)
+	for _, word := range s.words {
+		n += len(word)
+	}
+	return n
+}
+
+// ship returns s as a Ship with its words joined by separator. Whichever
+// catalogue s is from, it is canonical only if the built-in catalogue holds
+// its name.
+func (s catalogueShip) ship(separator string) Ship {
+	_, canonical := ships().canonical[s.name]
+
+	return Ship{
+		Name:       s.join(separator),
+		Class:      s.class,
+		Source:     s.source,
+		Words:      append([]string(nil), s.words...),
+		Canonical:  canonical,
+		TierWeight: s.tierWeight,
+	}
+}
+
+func words(cultureShip string) []string {
+	// Split the culture ship name on any run of whitespace, so that joining
+	// the words, even with an empty separator, never leaves a space behind
+	return strings.Fields(cultureShip)
+}
+
+// matchKey returns name lowercased, with every run of characters other than
+// letters and digits replaced by a single space and none at either end, so
+// that names differing only in case, punctuation or separator share a key.
+func matchKey(name string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(name), isWordBreak), " ")
+}
+
+// isWordBreak reports whether r is one of the characters other than letters
+// and digits that break a name into words.
+func isWordBreak(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
@@ -0,0 +1,148 @@
+package spaceships
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCatalogueShip_TrickySpacing(t *testing.T) {
+	tests := []struct {
+		cultureShip string
+		separator   string
+		want        string
+	}{
+		{"Dressed Up To Party ", "-", "Dressed-Up-To-Party"},
+		{" Boo!", "-", "Boo!"},
+		{"Of  Course I Still Love You", "-", "Of-Course-I-Still-Love-You"},
+		{"Thorough But...\tUnreliable", "_", "Thorough_But..._Unreliable"},
+		{"Mistake\u00a0Not...", "::", "Mistake::Not..."},
+		{"Me,  I'm Counting ", "", "Me,I'mCounting"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.cultureShip, func(t *testing.T) {
+			s := catalogueShip{words: words(tt.cultureShip)}
+
+			if got := s.join(tt.separator); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+
+			if got := s.length(tt.separator); got != len(tt.want) {
+				t.Errorf("expected length %d, got %d", len(tt.want), got)
+			}
+		})
+	}
+}
+
+// TestCatalogue_NoDoubledSeparators guards the raw catalogue: no ship joined
+// by a separator may start or end with it, or contain it twice in a row.
+func TestCatalogue_NoDoubledSeparators(t *testing.T) {
+	for _, s := range ships().ships {
+		got := s.join("-")
+
+		if strings.Contains(got, "--") || strings.HasPrefix(got, "-") || strings.HasSuffix(got, "-") {
+			t.Errorf("expected %q to join without stray separators, got %q", s.name, got)
+		}
+	}
+
+	for _, cultureShip := range cultureShips {
+		if got := strings.Join(words(cultureShip), " "); got != cultureShip {
+			t.Errorf("expected catalogue entry %q to be written as %q", cultureShip, got)
+		}
+	}
+}
+
+func TestCatalogue_Classes(t *testing.T) {
+	for _, class := range Classes() {
+		indexed := ships().classes[class]
+		// Filter without the class, so that the whole catalogue is scanned
+		// rather than the index being compared with itself
+		inClass := InClass(class)
+		filtered := matching([]Filter{{accept: inClass.Accepts}})
+
+		if len(indexed) != len(filtered) {
+			t.Fatalf("expected %d ships of class %s, got %d", len(filtered), class, len(indexed))
+		}
+
+		for i := range filtered {
+			if indexed[i].name != filtered[i].name {
+				t.Errorf("expected ship %d of class %s to be %q, got %q", i, class, filtered[i].name, indexed[i].name)
+			}
+		}
+	}
+}
+
+func BenchmarkGenerate(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		Generate("-")
+	}
+}
+
+func BenchmarkGenerateMatching(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := GenerateMatching("-", nil, WithWordBounds(2, 3)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkGenerateForClass and BenchmarkGenerateMatchingInClass compare
+// drawing a ship of a class from the class index with filtering the whole
+// catalogue on every call.
+func BenchmarkGenerateForClass(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := GenerateForClassWithRand(ClassGSV, "-", nil); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGenerateMatchingInClass(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := GenerateMatching("-", nil, InClass(ClassGSV)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestParseClass(t *testing.T) {
+	for name, want := range map[string][2]string{
+		"GSV Sleeper Service":  {ClassGSV, "Sleeper Service"},
+		"rou-killing-time":     {ClassROU, "killing-time"},
+		"Gcu::Grey_Area":       {ClassGCU, "Grey_Area"},
+		"VFP  Just Testing":    {ClassVFP, "Just Testing"},
+		"GSVSleeper Service":   {"", "GSVSleeper Service"},
+		"GSV":                  {"", "GSV"},
+		"GSV-":                 {"", "GSV-"},
+		"Sleeper Service":      {"", "Sleeper Service"},
+		"XYZ Sleeper Service":  {"", "XYZ Sleeper Service"},
+		"-GSV Sleeper Service": {"", "-GSV Sleeper Service"},
+		"":                     {"", ""},
+	} {
+		class, rest := ParseClass(name)
+		if class != want[0] || rest != want[1] {
+			t.Errorf("expected %q to parse into %q and %q, got %q and %q", name, want[0], want[1], class, rest)
+		}
+	}
+}
+
+func TestCatalogue_MatchingInClass(t *testing.T) {
+	c := newCatalogue([]string{"Sleeper Service", "Killing Time", "Not A Real Ship", "Quietly Confident"})
+
+	got := c.matching([]Filter{WithWordBounds(2, 2), InClass(ClassGSV)})
+	if len(got) != 1 || got[0].name != "Sleeper Service" {
+		t.Errorf("expected only Sleeper Service to match, got %v", got)
+	}
+
+	if got := c.matching([]Filter{InClass(ClassGSV), InClass(ClassROU)}); len(got) != 0 {
+		t.Errorf("expected no ship to be of two classes, got %v", got)
+	}
+}
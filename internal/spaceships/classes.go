@@ -0,0 +1,122 @@
+package spaceships
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+)
+
+// Ship classes, by their conventional abbreviation.
+const (
+	ClassGSV = "GSV" // General Systems Vehicle
+	ClassMSV = "MSV" // Medium Systems Vehicle
+	ClassLSV = "LSV" // Limited Systems Vehicle
+	ClassGCU = "GCU" // General Contact Unit
+	ClassLCU = "LCU" // Limited Contact Unit
+	ClassGOU = "GOU" // General Offensive Unit
+	ClassROU = "ROU" // Rapid Offensive Unit
+	ClassLOU = "LOU" // Limited Offensive Unit
+	ClassVFP = "VFP" // Very Fast Picket
+)
+
+var (
+	shipClasses = [...]string{
+		ClassGSV,
+		ClassMSV,
+		ClassLSV,
+		ClassGCU,
+		ClassLCU,
+		ClassGOU,
+		ClassROU,
+		ClassLOU,
+		ClassVFP,
+	}
+
+	// cultureShipClasses maps the ships whose class is attested in the books
+	// to that class. Ships missing from this map have no known class.
+	cultureShipClasses = map[string]string{
+		"Arbitrary":           ClassGCU,
+		"Attitude Adjuster":   ClassROU,
+		"Bora Horza Gobuchul": ClassGSV,
+		"Empiricist":          ClassGSV,
+		"Falling Outside the Normal Moral Constraints": ClassGOU,
+		"Grey Area":                           ClassGCU,
+		"Gunboat Diplomat":                    ClassROU,
+		"Heavy Messing":                       ClassROU,
+		"Just Read The Instructions":          ClassGCU,
+		"Killing Time":                        ClassROU,
+		"Lightly Seared On The Reality Grill": ClassROU,
+		"Limiting Factor":                     ClassGOU,
+		"Little Rascal":                       ClassGSV,
+		"Mistake Not...":                      ClassGCU,
+		"Of Course I Still Love You":          ClassGSV,
+		"Size Isn't Everything":               ClassGSV,
+		"Sleeper Service":                     ClassGSV,
+		"So Much For Subtlety":                ClassGSV,
+		"The Ends Of Invention":               ClassGSV,
+		"Xenophobe":                           ClassROU,
+	}
+)
+
+// Classes returns the abbreviations of every supported ship class, sorted.
+func Classes() []string {
+	classes := append([]string(nil), shipClasses[:]...)
+	sort.Strings(classes)
+	return classes
+}
+
+// GenerateForClass is like Generate, but only draws from the ships attested
+// for the given class. An error is returned if no ships of that class are
+// known.
+func GenerateForClass(class, separator string) (string, error) {
+	ship, err := GenerateForClassWithRand(class, separator, nil)
+	return ship.Name, err
+}
+
+// GenerateForClassWithRand is like GenerateForClass, but draws the ship from
+// the given source of randomness rather than the package's own, and also
+// returns what is known about the generated ship. The ships of each class are
+// indexed when the catalogue is built, so this takes constant time.
+func GenerateForClassWithRand(class, separator string, rnd *rand.Rand) (Ship, error) {
+	candidates := ships().classes[class]
+	if len(candidates) == 0 {
+		return Ship{}, fmt.Errorf("no ship names are known for class %q", class)
+	}
+
+	var i int
+	if rnd != nil {
+		i = rnd.Intn(len(candidates))
+	} else {
+		i = intn(len(candidates))
+	}
+
+	return candidates[i].ship(separator), nil
+}
+
+// ParseClass splits a name that begins with a ship class abbreviation, such
+// as "GSV Sleeper Service" or "rou-killing-time", into the class and the rest
+// of the name. The abbreviation is matched case-insensitively, must be a
+// whole word and must be followed by more of the name; the run of
+// punctuation, whitespace or separator characters after it is dropped, and
+// the rest is returned unchanged. If the name does not begin with a class,
+// the class is empty and the name is returned whole.
+func ParseClass(name string) (class, rest string) {
+	end := strings.IndexFunc(name, isWordBreak)
+	if end <= 0 {
+		return "", name
+	}
+
+	start := strings.IndexFunc(name[end:], func(r rune) bool { return !isWordBreak(r) })
+	if start < 0 {
+		return "", name
+	}
+
+	for _, c := range shipClasses {
+		if strings.EqualFold(name[:end], c) {
+			return c, name[end+start:]
+		}
+	}
+
+	return "", name
+}
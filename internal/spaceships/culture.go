@@ -5,9 +5,9 @@
 package spaceships
 
 import (
+	"hash/fnv"
 	"math/rand"
 	"strings"
-	"time"
 )
 
 var (
@@ -54,7 +54,7 @@ var (
 		"Displacement Activity",
 		"Don't Try This At Home",
 		"Dramatic Exit",
-		"Dressed Up To Party ",
+		"Dressed Up To Party",
 		"Eight Rounds Rapid",
 		"Empiricist",
 		"Eschatologist",
@@ -227,18 +227,206 @@ var (
 	}
 )
 
-func NonDeterministicMode() {
-	rand.Seed(time.Now().UnixNano())
-}
+// NonDeterministicMode is a no-op, kept for compatibility.
+//
+// Deprecated: The package draws from its own source of randomness, which is
+// seeded when the package is initialised, so there is no need to seed it.
+func NonDeterministicMode() {}
 
+// CultureShip returns a random ship name, as written in the books, or an empty
+// string if no ships are known.
 func CultureShip() string {
-	return cultureShips[rand.Intn(len(cultureShips))]
+	c := ships()
+	if len(c.ships) == 0 {
+		return ""
+	}
+	return c.ships[intn(len(c.ships))].name
+}
+
+// Ship is a generated ship name along with what is known about the ship.
+type Ship struct {
+	// Name is the ship name, with its words joined by the separator.
+	Name string
+	// Class is the abbreviation of the ship's class, or empty if the class
+	// is not known.
+	Class string
+	// Source is the title of the novel the ship appears in, or empty if it is
+	// not known.
+	Source string
+	// Words are the words of the ship name, as written in the books, that
+	// Name joins.
+	Words []string
+	// Canonical reports whether the ship name is attested in the books, as
+	// opposed to having been made up.
+	Canonical bool
+	// TierWeight is the weight of the ship's editorial tier, as drawn by
+	// GenerateByTier. It is a rough grade of the ship's prominence in the
+	// books, not a count of its mentions.
+	TierWeight int
 }
 
+// Generate returns a random ship name with its words joined by the separator,
+// or an empty string if no ships are known.
 func Generate(separator string) string {
-	cultureShip := CultureShip()
-	// Split the culture ship name by space
-	words := strings.Split(cultureShip, " ")
-	// Join the words with the separator
-	return strings.Join(words, separator)
+	return ships().generate(separator)
+}
+
+func (c *catalogue) generate(separator string) string {
+	if len(c.ships) == 0 {
+		return ""
+	}
+	return c.ships[intn(len(c.ships))].join(separator)
+}
+
+// GenerateWithMeta is like Generate, but also returns what is known about the
+// generated ship. The zero Ship is returned if no ships are known.
+func GenerateWithMeta(separator string) Ship {
+	c := ships()
+	if len(c.ships) == 0 {
+		return Ship{}
+	}
+	return c.ships[intn(len(c.ships))].ship(separator)
+}
+
+// GenerateWithRand is like GenerateWithMeta, but draws the ship from the given
+// source of randomness rather than the package's own, so that a seeded source
+// always yields the same ship.
+func GenerateWithRand(separator string, rnd *rand.Rand) Ship {
+	c := ships()
+	if len(c.ships) == 0 {
+		return Ship{}
+	}
+	return c.ships[rnd.Intn(len(c.ships))].ship(separator)
+}
+
+// GenerateForSeed returns the ship name picked by hashing seed, with its words
+// joined by the separator, so that the same seed always picks the same ship.
+// Ships are picked from the list returned by All, so a seed picks a different
+// ship only when the catalogue changes. An empty string is returned if no ships
+// are known.
+func GenerateForSeed(seed, separator string) string {
+	return ships().generateForSeed(seed, separator)
+}
+
+func (c *catalogue) generateForSeed(seed, separator string) string {
+	if len(c.names) == 0 {
+		return ""
+	}
+
+	h := fnv.New64a()
+	h.Write([]byte(seed))
+
+	return strings.Join(words(c.names[h.Sum64()%uint64(len(c.names))]), separator)
+}
+
+// GenerateDistinct returns n ship names, no two of them the same, drawn from
+// the given source of randomness, or the package's own if rnd is nil.
+// ErrNotEnoughShips is returned if fewer than n distinct ships are known.
+func GenerateDistinct(separator string, n int, rnd *rand.Rand) ([]string, error) {
+	if n > Count() {
+		return nil, ErrNotEnoughShips
+	}
+
+	c := ships()
+	order := make([]int, len(c.ships))
+	for i := range order {
+		order[i] = i
+	}
+
+	names := make([]string, 0, n)
+	seen := make(map[string]struct{}, n)
+
+	// Shuffle only as much of the catalogue as is needed, skipping any ship
+	// catalogued more than once
+	for i := 0; len(names) < n; i++ {
+		var j int
+		if rnd != nil {
+			j = i + rnd.Intn(len(order)-i)
+		} else {
+			j = i + intn(len(order)-i)
+		}
+		order[i], order[j] = order[j], order[i]
+
+		s := c.ships[order[i]]
+		if _, ok := seen[s.name]; ok {
+			continue
+		}
+
+		seen[s.name] = struct{}{}
+		names = append(names, s.join(separator))
+	}
+
+	return names, nil
+}
+
+// All returns the name of every known ship, deduplicated and sorted.
+func All() []string {
+	return append([]string(nil), ships().names...)
+}
+
+// Count returns the number of distinct known ships, which is the length of
+// the list returned by All.
+func Count() int {
+	return ships().count()
+}
+
+func (c *catalogue) count() int {
+	return len(c.names)
+}
+
+// LengthRange returns the lengths of the shortest and longest ship names that
+// Generate can produce with the given separator.
+func LengthRange(separator string) (int, int) {
+	return ships().lengthRange(separator)
+}
+
+func (c *catalogue) lengthRange(separator string) (int, int) {
+	shortest, longest := 0, 0
+	for i, s := range c.ships {
+		l := s.length(separator)
+		if i == 0 || l < shortest {
+			shortest = l
+		}
+		if l > longest {
+			longest = l
+		}
+	}
+	return shortest, longest
+}
+
+// Find returns the known ship whose name, with its words joined by the
+// separator, is equal to name under Unicode case-folding.
+func Find(name, separator string) (Ship, bool) {
+	return ships().find(name, separator)
+}
+
+func (c *catalogue) find(name, separator string) (Ship, bool) {
+	for _, s := range c.ships {
+		if strings.EqualFold(s.join(separator), name) {
+			return s.ship(separator), true
+		}
+	}
+	return Ship{}, false
+}
+
+// ClassOf returns the abbreviation of the class of the known ship name, or
+// false if name is not a known ship or its class is not known. Names are
+// matched as Contains matches them.
+func ClassOf(name string) (string, bool) {
+	class, ok := ships().classOf[matchKey(name)]
+	return class, ok
+}
+
+// Contains reports whether name is a known ship, ignoring case and treating
+// any run of punctuation, whitespace or separator characters as the break
+// between two words. Names joined with any separator, and the slug of any
+// known ship, are therefore found.
+func Contains(name string) bool {
+	key := matchKey(name)
+	if key == "" {
+		return false
+	}
+
+	_, ok := ships().keys[key]
+	return ok
 }
@@ -0,0 +1,322 @@
+package spaceships
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+func TestAll(t *testing.T) {
+	names := All()
+
+	if len(names) == 0 {
+		t.Fatal("expected at least one ship name")
+	}
+
+	if !sort.StringsAreSorted(names) {
+		t.Error("expected ship names to be sorted")
+	}
+
+	for i := 1; i < len(names); i++ {
+		if names[i] == names[i-1] {
+			t.Errorf("duplicate ship name %q", names[i])
+		}
+	}
+}
+
+func TestGenerate_MultiCharacterSeparator(t *testing.T) {
+	for _, separator := range []string{"__", "::", " - "} {
+		separator := separator
+		t.Run(separator, func(t *testing.T) {
+			for _, s := range ships().ships {
+				got := s.join(separator)
+				words := strings.Split(s.name, " ")
+
+				if want := strings.Join(words, separator); got != want {
+					t.Errorf("expected %q, got %q", want, got)
+				}
+
+				if l := s.length(separator); l != len(got) {
+					t.Errorf("expected length of %q to be %d, got %d", got, len(got), l)
+				}
+
+				if parts := strings.Split(got, separator); len(parts) != len(words) {
+					t.Errorf("expected %q to split back into %d words, got %d", got, len(words), len(parts))
+				}
+			}
+		})
+	}
+}
+
+func TestGenerate_EmptySeparator(t *testing.T) {
+	for _, s := range ships().ships {
+		got := s.join("")
+
+		if strings.ContainsFunc(got, unicode.IsSpace) {
+			t.Errorf("expected %q joined without a separator to contain no whitespace, got %q", s.name, got)
+		}
+
+		if l := s.length(""); l != len(got) {
+			t.Errorf("expected length of %q to be %d, got %d", got, len(got), l)
+		}
+	}
+
+	if got, want := words("Of  Course I\tStill Love You"), 6; len(got) != want {
+		t.Errorf("expected irregular whitespace to split into %d words, got %q", want, got)
+	}
+}
+
+func TestGenerate_Random(t *testing.T) {
+	seen := make(map[string]struct{})
+	for i := 0; i < 10; i++ {
+		seen[Generate("-")] = struct{}{}
+	}
+
+	if len(seen) == 1 {
+		t.Errorf("expected Generate to return different names without being seeded, got %d the same", 10)
+	}
+}
+
+func TestGenerateWithWordBounds(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		ship, err := GenerateWithWordBounds(" ", 2, 3)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if n := len(strings.Fields(ship)); n < 2 || n > 3 {
+			t.Fatalf("expected %q to have between 2 and 3 words, got %d", ship, n)
+		}
+	}
+
+	if _, err := GenerateWithWordBounds(" ", 100, 0); !errors.Is(err, ErrNoMatchingShips) {
+		t.Errorf("expected ErrNoMatchingShips, got %v", err)
+	}
+}
+
+func TestFind(t *testing.T) {
+	ship, ok := Find("SLEEPER-service", "-")
+	if !ok {
+		t.Fatal("expected to find Sleeper Service")
+	}
+
+	if ship.Name != "Sleeper-Service" {
+		t.Errorf("expected name %q, got %q", "Sleeper-Service", ship.Name)
+	}
+
+	if ship.Class != ClassGSV {
+		t.Errorf("expected class %q, got %q", ClassGSV, ship.Class)
+	}
+
+	if ship.Source != "Excession" {
+		t.Errorf("expected source %q, got %q", "Excession", ship.Source)
+	}
+
+	if !ship.Canonical {
+		t.Error("expected Sleeper Service to be canonical")
+	}
+
+	if _, ok := Find("Sleeper Service", "-"); ok {
+		t.Error("expected names joined by a different separator not to be found")
+	}
+}
+
+func TestContains(t *testing.T) {
+	tests := map[string]bool{
+		"Sleeper Service":               true,
+		"sleeper service":               true,
+		"SLEEPER-SERVICE":               true,
+		"sleeper_service":               true,
+		"Sleeper::Service":              true,
+		"SleeperService":                false,
+		"funny-it-worked-last-time":     true,
+		"Funny, It Worked Last Time...": true,
+		"don_t try-this at home":        true,
+		"Sleeper Service Two":           false,
+		"":                              false,
+		"---":                           false,
+	}
+
+	for name, want := range tests {
+		if got := Contains(name); got != want {
+			t.Errorf("expected Contains(%q) to be %t, got %t", name, want, got)
+		}
+	}
+
+	for _, name := range All() {
+		if !Contains(name) {
+			t.Errorf("expected known ship %q to be found", name)
+		}
+	}
+}
+
+func TestClassOf(t *testing.T) {
+	tests := map[string]string{
+		"Sleeper Service": ClassGSV,
+		"sleeper-service": ClassGSV,
+		"GREY_AREA":       ClassGCU,
+		// Known ships whose class is not known, and unknown ships, have none
+		"Ablation":        "",
+		"Not A Real Ship": "",
+		"":                "",
+	}
+
+	for name, want := range tests {
+		got, ok := ClassOf(name)
+		if got != want || ok != (want != "") {
+			t.Errorf("expected ClassOf(%q) to be %q, %t, got %q, %t", name, want, want != "", got, ok)
+		}
+	}
+}
+
+func TestGenerateExcluding(t *testing.T) {
+	exclude := All()[1:]
+	for i := range exclude {
+		exclude[i] = strings.ToUpper(exclude[i])
+	}
+
+	ship, err := GenerateExcluding(" ", exclude)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if ship != All()[0] {
+		t.Errorf("expected the only ship not excluded, %q, got %q", All()[0], ship)
+	}
+
+	if _, err := GenerateExcluding(" ", All()); !errors.Is(err, ErrNoMatchingShips) {
+		t.Errorf("expected ErrNoMatchingShips, got %v", err)
+	}
+}
+
+func TestGenerateCanonical(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		ship, err := GenerateCanonical(" ")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if _, ok := ships().canonical[ship]; !ok {
+			t.Fatalf("expected %q to be attested in the books", ship)
+		}
+	}
+
+	if Canonical().Accepts("Not A Real Ship") {
+		t.Error("expected a name not in the books to be rejected")
+	}
+}
+
+func TestSources(t *testing.T) {
+	for cultureShip := range cultureShipSources {
+		if _, ok := ships().canonical[cultureShip]; !ok {
+			t.Errorf("expected ship %q with a source to be catalogued", cultureShip)
+		}
+	}
+}
+
+func TestGenerateDistinct(t *testing.T) {
+	names, err := GenerateDistinct("-", Count(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(names) != Count() {
+		t.Fatalf("expected %d names, got %d", Count(), len(names))
+	}
+
+	seen := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		if _, ok := seen[name]; ok {
+			t.Errorf("duplicate ship name %q", name)
+		}
+		seen[name] = struct{}{}
+	}
+
+	if _, err := GenerateDistinct("-", Count()+1, nil); !errors.Is(err, ErrNotEnoughShips) {
+		t.Errorf("expected ErrNotEnoughShips, got %v", err)
+	}
+}
+
+func TestGenerateForSeed(t *testing.T) {
+	name := GenerateForSeed("project-1234", "-")
+	if name == "" {
+		t.Fatal("expected a ship name")
+	}
+
+	for i := 0; i < 10; i++ {
+		if got := GenerateForSeed("project-1234", "-"); got != name {
+			t.Fatalf("expected the same seed to give %q, got %q", name, got)
+		}
+	}
+
+	spaced := GenerateForSeed("project-1234", " ")
+	if got, want := GenerateForSeed("project-1234", "_"), strings.Join(strings.Fields(spaced), "_"); got != want {
+		t.Errorf("expected the separator to only change how %q is joined, got %q", spaced, got)
+	}
+
+	names := make(map[string]struct{})
+	for i := 0; i < 50; i++ {
+		names[GenerateForSeed(fmt.Sprintf("project-%d", i), " ")] = struct{}{}
+	}
+	if len(names) < 2 {
+		t.Errorf("expected different seeds to give different ship names, got %v", names)
+	}
+}
+
+func TestCountMatching(t *testing.T) {
+	if got := CountMatching(); got != Count() {
+		t.Errorf("expected every one of the %d ships to match no filters, got %d", Count(), got)
+	}
+
+	if got := CountMatching(IncludingOnly([]string{"Sleeper Service", "GREY AREA", "Not A Real Ship"})); got != 2 {
+		t.Errorf("expected 2 matching ships, got %d", got)
+	}
+
+	if got := CountMatching(IncludingOnly(nil)); got != 0 {
+		t.Errorf("expected no matching ships, got %d", got)
+	}
+}
+
+func TestGenerateAt(t *testing.T) {
+	include := IncludingOnly([]string{"Sleeper Service", "Grey Area", "Ablation"})
+
+	for index, want := range map[int]string{
+		0:  "Ablation",
+		1:  "Grey-Area",
+		2:  "Sleeper-Service",
+		4:  "Grey-Area",
+		-1: "Sleeper-Service",
+	} {
+		ship, err := GenerateAt("-", index, include)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if ship.Name != want {
+			t.Errorf("expected index %d to give %q, got %q", index, want, ship.Name)
+		}
+	}
+
+	if ship, _ := GenerateAt(" ", Count()); ship.Name != All()[0] {
+		t.Errorf("expected index %d to wrap around to %q, got %q", Count(), All()[0], ship.Name)
+	}
+
+	if _, err := GenerateAt("-", 0, IncludingOnly(nil)); !errors.Is(err, ErrNoMatchingShips) {
+		t.Errorf("expected ErrNoMatchingShips, got %v", err)
+	}
+}
+
+// TestCount pins the size of the catalogue, so that adding or removing ships
+// shows up in review.
+func TestCount(t *testing.T) {
+	if got, want := Count(), 212; got != want {
+		t.Errorf("expected %d known ships, got %d", want, got)
+	}
+
+	if got := len(All()); got != Count() {
+		t.Errorf("expected Count to match the %d ships returned by All, got %d", got, Count())
+	}
+}
@@ -0,0 +1,221 @@
+package spaceships
+
+import (
+	"errors"
+	"math/rand"
+	"regexp"
+	"strings"
+)
+
+// ErrNoMatchingShips is returned when no known ship satisfies the filters
+// given to GenerateMatching.
+var ErrNoMatchingShips = errors.New("no known ship names match")
+
+// ErrNotEnoughShips is returned when more distinct ship names are requested
+// from GenerateDistinct than are known.
+var ErrNotEnoughShips = errors.New("not enough distinct ship names are known")
+
+// Filter decides whether the ship with a given name, as written in the
+// books, may be generated.
+type Filter struct {
+	accept func(cultureShip string) bool
+	// class, if set, is the class every accepted ship is attested for, so
+	// that matching can start from the catalogue's index of that class.
+	class string
+}
+
+// Accepts reports whether the ship with the given name, as written in the
+// books, may be generated.
+func (f Filter) Accepts(cultureShip string) bool {
+	return f.accept(cultureShip)
+}
+
+// InClass returns a Filter accepting the ships attested for the given class.
+func InClass(class string) Filter {
+	return Filter{
+		accept: func(cultureShip string) bool {
+			return cultureShipClasses[cultureShip] == class
+		},
+		class: class,
+	}
+}
+
+// WithWordBounds returns a Filter accepting the ships whose names have at
+// least min and at most max words. A bound of zero is not enforced.
+func WithWordBounds(min, max int) Filter {
+	return Filter{accept: func(cultureShip string) bool {
+		n := strings.Count(cultureShip, " ") + 1
+		return (min == 0 || n >= min) && (max == 0 || n <= max)
+	}}
+}
+
+// MatchingRegexp returns a Filter accepting the ships whose names, as
+// written in the books, match re.
+func MatchingRegexp(re *regexp.Regexp) Filter {
+	return Filter{accept: func(cultureShip string) bool {
+		return re.MatchString(cultureShip)
+	}}
+}
+
+// Excluding returns a Filter rejecting the ships whose names, as written in
+// the books, are in exclude. Names are compared case-insensitively.
+func Excluding(exclude []string) Filter {
+	excluded := make(map[string]struct{}, len(exclude))
+	for _, name := range exclude {
+		excluded[strings.ToLower(name)] = struct{}{}
+	}
+
+	return Filter{accept: func(cultureShip string) bool {
+		_, ok := excluded[strings.ToLower(cultureShip)]
+		return !ok
+	}}
+}
+
+// IncludingOnly returns a Filter accepting only the ships whose names, as
+// written in the books, are in include. Names are compared case-insensitively.
+func IncludingOnly(include []string) Filter {
+	included := make(map[string]struct{}, len(include))
+	for _, name := range include {
+		included[strings.ToLower(name)] = struct{}{}
+	}
+
+	return Filter{accept: func(cultureShip string) bool {
+		_, ok := included[strings.ToLower(cultureShip)]
+		return ok
+	}}
+}
+
+// Canonical returns a Filter accepting only the ships whose names are
+// attested in the books, as opposed to names from any other source. Every
+// built-in ship is attested.
+func Canonical() Filter {
+	return Filter{accept: func(cultureShip string) bool {
+		_, ok := ships().canonical[cultureShip]
+		return ok
+	}}
+}
+
+// GenerateMatching is like GenerateWithRand, but only draws from the ships
+// accepted by every filter. If rnd is nil, the package's own source of
+// randomness is used. ErrNoMatchingShips is returned if no ship satisfies
+// the filters.
+func GenerateMatching(separator string, rnd *rand.Rand, filters ...Filter) (Ship, error) {
+	return ships().generateMatching(separator, rnd, filters)
+}
+
+func (c *catalogue) generateMatching(separator string, rnd *rand.Rand, filters []Filter) (Ship, error) {
+	candidates := c.matching(filters)
+	if len(candidates) == 0 {
+		return Ship{}, ErrNoMatchingShips
+	}
+
+	var i int
+	if rnd != nil {
+		i = rnd.Intn(len(candidates))
+	} else {
+		i = intn(len(candidates))
+	}
+
+	return candidates[i].ship(separator), nil
+}
+
+// CountMatching returns the number of distinct ship names accepted by every
+// filter, which is how many GenerateMatching can draw from.
+func CountMatching(filters ...Filter) int {
+	return ships().countMatching(filters)
+}
+
+func (c *catalogue) countMatching(filters []Filter) int {
+	candidates := c.matching(filters)
+
+	seen := make(map[string]struct{}, len(candidates))
+	for _, s := range candidates {
+		seen[s.name] = struct{}{}
+	}
+
+	return len(seen)
+}
+
+// GenerateAt returns the ship accepted by every filter at the given index,
+// wrapping around, in the sorted list of distinct matching names. Unlike the
+// other Generate functions it draws nothing at random, so the same index and
+// filters always give the same ship. ErrNoMatchingShips is returned if no
+// ship satisfies the filters.
+func GenerateAt(separator string, index int, filters ...Filter) (Ship, error) {
+	return ships().generateAt(separator, index, filters)
+}
+
+func (c *catalogue) generateAt(separator string, index int, filters []Filter) (Ship, error) {
+	distinct := c.subset(filters).distinct
+	if len(distinct) == 0 {
+		return Ship{}, ErrNoMatchingShips
+	}
+
+	i := index % len(distinct)
+	if i < 0 {
+		i += len(distinct)
+	}
+
+	return distinct[i].ship(separator), nil
+}
+
+// GenerateWithWordBounds is like Generate, but only draws from the ships
+// whose names have between min and max words, inclusive. A bound of zero is
+// not enforced. ErrNoMatchingShips is returned if no ship name has a word
+// count within the bounds.
+func GenerateWithWordBounds(separator string, min, max int) (string, error) {
+	ship, err := GenerateMatching(separator, nil, WithWordBounds(min, max))
+	return ship.Name, err
+}
+
+// GenerateExcluding is like Generate, but never draws a ship whose name, as
+// written in the books, is in exclude. Names are compared case-insensitively.
+// ErrNoMatchingShips is returned if every ship is excluded.
+func GenerateExcluding(separator string, exclude []string) (string, error) {
+	ship, err := GenerateMatching(separator, nil, Excluding(exclude))
+	return ship.Name, err
+}
+
+// GenerateCanonical is like Generate, but only draws from the ships whose
+// names are attested in the books.
+func GenerateCanonical(separator string) (string, error) {
+	ship, err := GenerateMatching(separator, nil, Canonical())
+	return ship.Name, err
+}
+
+// matching returns the ships of the built-in catalogue accepted by every
+// filter.
+func matching(filters []Filter) []catalogueShip {
+	return ships().matching(filters)
+}
+
+// matching returns the catalogued ships accepted by every filter. Without
+// filters, this is the catalogue itself, which must not be modified. If a
+// filter is for a class, only the ships indexed for that class are checked.
+func (c *catalogue) matching(filters []Filter) []catalogueShip {
+	all := c.ships
+	if len(filters) == 0 {
+		return all
+	}
+
+	for _, filter := range filters {
+		if filter.class != "" {
+			all = c.classes[filter.class]
+			break
+		}
+	}
+
+	candidates := make([]catalogueShip, 0, len(all))
+
+next:
+	for _, s := range all {
+		for _, filter := range filters {
+			if !filter.Accepts(s.name) {
+				continue next
+			}
+		}
+		candidates = append(candidates, s)
+	}
+
+	return candidates
+}
@@ -0,0 +1,181 @@
+package spaceships
+
+import (
+	"errors"
+	"math/rand"
+	"strings"
+)
+
+// Generator generates ship names. Code that generates names through a
+// Generator, rather than the package functions, can be given a fake in tests
+// to make the names predictable.
+type Generator interface {
+	// Generate returns a random ship name, with its words joined by the
+	// separator.
+	Generate(separator string) string
+	// GenerateMatching returns a random ship accepted by every filter, drawn
+	// from rnd, or from a source of the Generator's choosing if rnd is nil.
+	// ErrNoMatchingShips is returned if no ship satisfies the filters.
+	GenerateMatching(separator string, rnd *rand.Rand, filters ...Filter) (Ship, error)
+	// GenerateMatchingWeighted is like GenerateMatching, but each iconic
+	// ship is iconicWeight times more likely to be drawn than any other.
+	GenerateMatchingWeighted(separator string, rnd *rand.Rand, iconicWeight int, filters ...Filter) (Ship, error)
+	// GenerateAt returns the ship accepted by every filter at the given
+	// index, so that the same index always gives the same ship.
+	// ErrNoMatchingShips is returned if no ship satisfies the filters.
+	GenerateAt(separator string, index int, filters ...Filter) (Ship, error)
+	// CountMatching returns the number of distinct ship names accepted by
+	// every filter.
+	CountMatching(filters ...Filter) int
+	// Count returns the number of distinct ship names.
+	Count() int
+	// All returns the name of every ship, deduplicated and sorted.
+	All() []string
+	// Matching returns a Generator drawing only from the ships accepted by
+	// every filter. The ships are matched once, so that drawing many of them
+	// does not filter the whole catalogue for each.
+	Matching(filters ...Filter) Generator
+	// Find returns the ship whose name, with its words joined by the
+	// separator, is equal to name under Unicode case-folding.
+	Find(name, separator string) (Ship, bool)
+	// LengthRange returns the lengths of the shortest and longest ship names
+	// with the given separator.
+	LengthRange(separator string) (int, int)
+}
+
+// CatalogueGenerator is the Generator drawing from the known ships, with the
+// package's own source of randomness.
+type CatalogueGenerator struct{}
+
+var _ Generator = CatalogueGenerator{}
+
+// Generate is like the package function Generate.
+func (CatalogueGenerator) Generate(separator string) string {
+	return Generate(separator)
+}
+
+// GenerateMatching is like the package function GenerateMatching.
+func (CatalogueGenerator) GenerateMatching(separator string, rnd *rand.Rand, filters ...Filter) (Ship, error) {
+	return GenerateMatching(separator, rnd, filters...)
+}
+
+// GenerateMatchingWeighted is like the package function
+// GenerateMatchingWeighted.
+func (CatalogueGenerator) GenerateMatchingWeighted(separator string, rnd *rand.Rand, iconicWeight int, filters ...Filter) (Ship, error) {
+	return GenerateMatchingWeighted(separator, rnd, iconicWeight, filters...)
+}
+
+// GenerateAt is like the package function GenerateAt.
+func (CatalogueGenerator) GenerateAt(separator string, index int, filters ...Filter) (Ship, error) {
+	return GenerateAt(separator, index, filters...)
+}
+
+// CountMatching is like the package function CountMatching.
+func (CatalogueGenerator) CountMatching(filters ...Filter) int {
+	return CountMatching(filters...)
+}
+
+// Count is like the package function Count.
+func (CatalogueGenerator) Count() int {
+	return Count()
+}
+
+// All is like the package function All.
+func (CatalogueGenerator) All() []string {
+	return All()
+}
+
+// Matching returns a Generator drawing only from the known ships accepted by
+// every filter.
+func (CatalogueGenerator) Matching(filters ...Filter) Generator {
+	if len(filters) == 0 {
+		return CatalogueGenerator{}
+	}
+	return listGenerator{c: ships().subset(filters)}
+}
+
+// Find is like the package function Find.
+func (CatalogueGenerator) Find(name, separator string) (Ship, bool) {
+	return Find(name, separator)
+}
+
+// LengthRange is like the package function LengthRange.
+func (CatalogueGenerator) LengthRange(separator string) (int, int) {
+	return LengthRange(separator)
+}
+
+// ErrEmptyCatalogue is returned by NewListGenerator when it is given no ship
+// names.
+var ErrEmptyCatalogue = errors.New("no ship names are given")
+
+// NewListGenerator returns a Generator drawing from the given ship names
+// instead of the known ships, with the package's own source of randomness.
+// Blank names are ignored, as are names that Contains would match against an
+// earlier name, and ships that are also known take their class, source and
+// iconic status from the known ships. Only those ships are canonical, as
+// Canonical accepts the ships of the books whatever the generator.
+// ErrEmptyCatalogue is returned if no names remain.
+func NewListGenerator(names []string) (Generator, error) {
+	ships := make([]string, 0, len(names))
+	seen := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		key := matchKey(name)
+		if _, ok := seen[key]; ok || strings.TrimSpace(name) == "" {
+			continue
+		}
+
+		seen[key] = struct{}{}
+		ships = append(ships, name)
+	}
+
+	if len(ships) == 0 {
+		return nil, ErrEmptyCatalogue
+	}
+
+	return listGenerator{c: newCatalogue(ships)}, nil
+}
+
+// listGenerator is the Generator returned by NewListGenerator.
+type listGenerator struct {
+	c *catalogue
+}
+
+func (g listGenerator) Generate(separator string) string {
+	return g.c.generate(separator)
+}
+
+func (g listGenerator) GenerateMatching(separator string, rnd *rand.Rand, filters ...Filter) (Ship, error) {
+	return g.c.generateMatching(separator, rnd, filters)
+}
+
+func (g listGenerator) GenerateMatchingWeighted(separator string, rnd *rand.Rand, iconicWeight int, filters ...Filter) (Ship, error) {
+	return g.c.generateMatchingWeighted(separator, rnd, iconicWeight, filters)
+}
+
+func (g listGenerator) GenerateAt(separator string, index int, filters ...Filter) (Ship, error) {
+	return g.c.generateAt(separator, index, filters)
+}
+
+func (g listGenerator) CountMatching(filters ...Filter) int {
+	return g.c.countMatching(filters)
+}
+
+func (g listGenerator) Count() int {
+	return g.c.count()
+}
+
+func (g listGenerator) All() []string {
+	return append([]string(nil), g.c.names...)
+}
+
+func (g listGenerator) Matching(filters ...Filter) Generator {
+	return listGenerator{c: g.c.subset(filters)}
+}
+
+func (g listGenerator) Find(name, separator string) (Ship, bool) {
+	return g.c.find(name, separator)
+}
+
+func (g listGenerator) LengthRange(separator string) (int, int) {
+	return g.c.lengthRange(separator)
+}
@@ -0,0 +1,107 @@
+package spaceships
+
+import (
+	"errors"
+	"math/rand"
+	"regexp"
+	"testing"
+)
+
+func TestNewListGenerator(t *testing.T) {
+	g, err := NewListGenerator([]string{"Zephyr  Of Doubt", "", "Sleeper Service", "sleeper-service"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := g.Count(); got != 2 {
+		t.Errorf("expected 2 distinct ships, got %d", got)
+	}
+
+	if shortest, longest := g.LengthRange("-"); shortest != 15 || longest != 15 {
+		t.Errorf("expected lengths between 15 and 15, got %d and %d", shortest, longest)
+	}
+
+	ship, err := g.GenerateMatching("-", nil, InClass(ClassGSV))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if ship.Name != "Sleeper-Service" || ship.Source != "Excession" || !ship.Canonical {
+		t.Errorf("expected Sleeper Service with what is known about it, got %+v", ship)
+	}
+
+	ship, err = g.GenerateMatching("_", nil, MatchingRegexp(regexp.MustCompile("Zephyr")))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if ship.Name != "Zephyr_Of_Doubt" || ship.Class != "" || ship.Canonical {
+		t.Errorf("expected Zephyr Of Doubt with nothing known about it, got %+v", ship)
+	}
+
+	if ship, ok := g.Find("zephyr-of-doubt", "-"); !ok || len(ship.Words) != 3 {
+		t.Errorf("expected to find Zephyr Of Doubt among the given names, got %+v", ship)
+	}
+
+	if _, ok := g.Find("Grey-Area", "-"); ok {
+		t.Error("expected a known ship not among the given names not to be found")
+	}
+
+	if got := g.CountMatching(Canonical()); got != 1 {
+		t.Errorf("expected 1 canonical ship, got %d", got)
+	}
+
+	if _, err := NewListGenerator([]string{"", "  "}); !errors.Is(err, ErrEmptyCatalogue) {
+		t.Errorf("expected ErrEmptyCatalogue, got %v", err)
+	}
+}
+
+func TestCatalogueGenerator_Matching(t *testing.T) {
+	filters := []Filter{WithWordBounds(2, 3), Excluding([]string{"Grey Area"})}
+	matching := CatalogueGenerator{}.Matching(filters...)
+
+	if got, want := matching.Count(), CountMatching(filters...); got != want {
+		t.Fatalf("expected %d matching ships, got %d", want, got)
+	}
+
+	for index := 0; index < 10; index++ {
+		got, _ := matching.GenerateAt("-", index)
+		want, _ := GenerateAt("-", index, filters...)
+		if got.Name != want.Name {
+			t.Errorf("expected ship %d to be %q, got %q", index, want.Name, got.Name)
+		}
+	}
+}
+
+func TestDrawCumulative(t *testing.T) {
+	candidates := ships().ships[:5]
+	weights := []int{1, 3, 1, 2, 5}
+	cumulative := cumulativeWeights(candidates, func(s catalogueShip) int {
+		for i := range candidates {
+			if candidates[i].name == s.name {
+				return weights[i]
+			}
+		}
+		return 1
+	})
+
+	// The same seed gives the same n, which a linear scan of the weights
+	// must agree with
+	drawRand, scanRand := rand.New(rand.NewSource(7)), rand.New(rand.NewSource(7))
+	for i := 0; i < 100; i++ {
+		got := drawCumulative(candidates, cumulative, drawRand)
+
+		n := scanRand.Intn(cumulative[len(cumulative)-1])
+		want := -1
+		for j, weight := range weights {
+			if n -= weight; n < 0 {
+				want = j
+				break
+			}
+		}
+
+		if got.name != candidates[want].name {
+			t.Fatalf("expected draw %d to be %q, got %q", i, candidates[want].name, got.name)
+		}
+	}
+}
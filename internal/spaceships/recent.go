@@ -0,0 +1,67 @@
+package spaceships
+
+import "sync"
+
+// RecentWindow remembers the last few names handed out, so that they are not
+// repeated soon after. Unlike a Registry, a name may be handed out again once
+// it has dropped out of the window. A RecentWindow is safe for concurrent
+// use.
+type RecentWindow struct {
+	mu sync.Mutex
+	// names is a ring buffer of the most recent names, oldest at next once
+	// it is full.
+	names []string
+	next  int
+}
+
+// NewRecentWindow returns a RecentWindow remembering the last size names. A
+// window of size zero or less remembers nothing and admits every name.
+func NewRecentWindow(size int) *RecentWindow {
+	if size < 0 {
+		size = 0
+	}
+	return &RecentWindow{names: make([]string, 0, size)}
+}
+
+// Contains reports whether name is among the names in the window, without
+// recording it, so that a name can be checked before it is known to be
+// handed out.
+func (w *RecentWindow) Contains(name string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.contains(name)
+}
+
+// Admit records name as handed out, reporting whether it is not among the
+// names in the window. A name already in the window is not recorded again.
+func (w *RecentWindow) Admit(name string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if cap(w.names) == 0 {
+		return true
+	}
+
+	if w.contains(name) {
+		return false
+	}
+
+	if len(w.names) < cap(w.names) {
+		w.names = append(w.names, name)
+		return true
+	}
+
+	w.names[w.next] = name
+	w.next = (w.next + 1) % len(w.names)
+	return true
+}
+
+func (w *RecentWindow) contains(name string) bool {
+	for _, recent := range w.names {
+		if recent == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+package spaceships
+
+import "testing"
+
+func TestRecentWindow(t *testing.T) {
+	w := NewRecentWindow(2)
+
+	for _, step := range []struct {
+		name string
+		want bool
+	}{
+		{"Sleeper Service", true},
+		{"Sleeper Service", false},
+		{"Grey Area", true},
+		{"Sleeper Service", false},
+		{"Ablation", true},
+		// Sleeper Service has dropped out of the window
+		{"Sleeper Service", true},
+		// and now Grey Area has too
+		{"Grey Area", true},
+		{"Sleeper Service", false},
+	} {
+		if got := w.Admit(step.name); got != step.want {
+			t.Fatalf("expected Admit(%q) to be %t, got %t", step.name, step.want, got)
+		}
+	}
+}
+
+func TestRecentWindow_Contains(t *testing.T) {
+	w := NewRecentWindow(1)
+
+	if w.Contains("Sleeper Service") {
+		t.Fatal("expected an empty window not to contain Sleeper Service")
+	}
+	// Contains records nothing, so the name is still admitted
+	if !w.Admit("Sleeper Service") {
+		t.Fatal("expected Sleeper Service to be admitted after Contains")
+	}
+	if !w.Contains("Sleeper Service") {
+		t.Fatal("expected the window to contain the admitted Sleeper Service")
+	}
+
+	if w.Contains("Grey Area") {
+		t.Fatal("expected the window not to contain Grey Area")
+	}
+	// nor does it push the admitted name out of the window
+	if w.Admit("Sleeper Service") {
+		t.Fatal("expected Sleeper Service to still be in the window")
+	}
+}
+
+func TestRecentWindow_Disabled(t *testing.T) {
+	w := NewRecentWindow(0)
+
+	for i := 0; i < 3; i++ {
+		if !w.Admit("Sleeper Service") {
+			t.Fatal("expected a window of size zero to admit every name")
+		}
+	}
+}
+
+func TestRecentWindow_ManyNames(t *testing.T) {
+	const size = 20
+
+	w := NewRecentWindow(size)
+
+	var admitted []string
+	for len(admitted) < 500 {
+		name := Generate(" ")
+		if w.Admit(name) {
+			admitted = append(admitted, name)
+		}
+	}
+
+	for i := range admitted {
+		for j := i + 1; j < len(admitted) && j <= i+size; j++ {
+			if admitted[i] == admitted[j] {
+				t.Fatalf("expected no repeat within %d names, got %q at %d and %d", size, admitted[i], i, j)
+			}
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package spaceships
+
+import "sync"
+
+// Registry tracks the names handed out under ensure_unique. It only lives as
+// long as the provider configuration that owns it, so names are unique
+// within a single Terraform run but not across runs. A Registry is safe for
+// concurrent use.
+type Registry struct {
+	mu    sync.Mutex
+	names map[string]struct{}
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{names: make(map[string]struct{})}
+}
+
+// Claim records name as handed out, reporting whether it was unseen. A name
+// that has already been claimed cannot be claimed again until it is
+// released.
+func (r *Registry) Claim(name string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.names[name]; ok {
+		return false
+	}
+
+	r.names[name] = struct{}{}
+	return true
+}
+
+// Release forgets the given names, so that a batch that was claimed but
+// never handed out does not stay reserved.
+func (r *Registry) Release(names ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, name := range names {
+		delete(r.names, name)
+	}
+}
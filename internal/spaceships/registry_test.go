@@ -0,0 +1,67 @@
+package spaceships
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestRegistry_Claim(t *testing.T) {
+	const name = "test-claim-ship"
+
+	registry := NewRegistry()
+	if !registry.Claim(name) {
+		t.Fatalf("expected first claim of %q to succeed", name)
+	}
+
+	if registry.Claim(name) {
+		t.Fatalf("expected second claim of %q to fail", name)
+	}
+
+	if !NewRegistry().Claim(name) {
+		t.Fatalf("expected a claim of %q in a separate registry to succeed", name)
+	}
+}
+
+func TestRegistry_Release(t *testing.T) {
+	registry := NewRegistry()
+	registry.Claim("one")
+	registry.Claim("two")
+	registry.Claim("three")
+
+	registry.Release("one", "two")
+
+	if !registry.Claim("one") || !registry.Claim("two") {
+		t.Errorf("expected released names to be claimable again")
+	}
+	if registry.Claim("three") {
+		t.Errorf("expected a name that was not released to stay claimed")
+	}
+}
+
+func TestRegistry_Concurrent(t *testing.T) {
+	const name = "test-claim-concurrent-ship"
+
+	registry := NewRegistry()
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	claimed := 0
+
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if registry.Claim(name) {
+				mu.Lock()
+				claimed++
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if claimed != 1 {
+		t.Errorf("expected exactly one successful claim, got %d", claimed)
+	}
+}
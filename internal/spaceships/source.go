@@ -0,0 +1,40 @@
+package spaceships
+
+import (
+	crand "crypto/rand"
+	"encoding/binary"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// source is the package's own source of randomness, used whenever no source
+// is given. It is seeded once from newSeed, rather than reseeding the global math/rand
+// source that other code in the process may depend on. A *rand.Rand is not
+// safe for concurrent use, so every draw holds the lock.
+var source = struct {
+	sync.Mutex
+	rnd *rand.Rand
+}{
+	rnd: rand.New(rand.NewSource(newSeed())),
+}
+
+// newSeed returns a high-entropy seed read from crypto/rand, so that
+// processes started within the same clock tick do not share a sequence of
+// ship names. It falls back to the current time if crypto/rand fails.
+func newSeed() int64 {
+	var b [8]byte
+	if _, err := crand.Read(b[:]); err != nil {
+		return time.Now().UnixNano()
+	}
+
+	return int64(binary.LittleEndian.Uint64(b[:]))
+}
+
+// intn returns a random number in [0, n) from the package's source.
+func intn(n int) int {
+	source.Lock()
+	defer source.Unlock()
+
+	return source.rnd.Intn(n)
+}
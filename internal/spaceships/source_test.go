@@ -0,0 +1,60 @@
+package spaceships
+
+import (
+	"math"
+	"sync"
+	"testing"
+)
+
+// TestGenerate_Concurrent is meant to be run with -race, which reports any
+// unsynchronised use of the package's source of randomness.
+func TestGenerate_Concurrent(t *testing.T) {
+	var wg sync.WaitGroup
+
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if Generate("-") == "" {
+					t.Error("expected a ship name")
+				}
+				if _, err := GenerateMatching("-", nil, InClass(ClassGSV)); err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestNewSeed(t *testing.T) {
+	seen := make(map[int64]struct{})
+
+	for i := 0; i < 100; i++ {
+		seed := newSeed()
+		if _, ok := seen[seed]; ok {
+			t.Fatalf("expected distinct seeds, got %d twice", seed)
+		}
+		seen[seed] = struct{}{}
+	}
+
+	// Names drawn from the seeded source should repeat about as often as
+	// uniform draws from the catalogue do. n draws from k names are expected
+	// to give k*(1-(1-1/k)^n) distinct ones; a source stuck on a handful of
+	// values gives far fewer, so anything under half of that fails.
+	const draws = 500
+
+	names := make(map[string]struct{})
+	for i := 0; i < draws; i++ {
+		names[Generate("-")] = struct{}{}
+	}
+
+	known := float64(Count())
+	expected := known * (1 - math.Pow(1-1/known, draws))
+	if distinct := float64(len(names)); distinct < expected/2 {
+		t.Errorf("expected about %.0f distinct names in %d draws from %d known, got %.0f",
+			expected, draws, Count(), distinct)
+	}
+}
@@ -0,0 +1,26 @@
+package spaceships
+
+var (
+	// cultureShipSources maps the ships whose appearance in the books is
+	// known to the novel they appear in. Ships missing from this map have no
+	// known source.
+	cultureShipSources = map[string]string{
+		"Anticipation Of A New Lover's Arrival, The": "Excession",
+		"Arbitrary":         "Excession",
+		"Attitude Adjuster": "Excession",
+		"Falling Outside the Normal Moral Constraints": "Surface Detail",
+		"Fate Amenable To Change":                      "Excession",
+		"Grey Area":                                    "Excession",
+		"Just Read The Instructions":                   "The Player of Games",
+		"Killing Time":                                 "Excession",
+		"Limiting Factor":                              "The Player of Games",
+		"Little Rascal":                                "The Player of Games",
+		"Mistake Not...":                               "The Player of Games",
+		"Of Course I Still Love You":                   "The Player of Games",
+		"Serious Callers Only":                         "Excession",
+		"Shoot Them Later":                             "Excession",
+		"Sleeper Service":                              "Excession",
+		"Steely Glint":                                 "Excession",
+		"Unfortunate Conflict Of Evidence":             "Excession",
+	}
+)
@@ -0,0 +1,45 @@
+package spaceships
+
+import "math/rand"
+
+// The tier weights are an editorial judgement, not mention-frequency data:
+// no per-ship mention counts are catalogued. Each ship is put in a tier by
+// what the catalogue knows of it. The iconic ships carry whole plots, ships
+// with a known source appear in a novel's story, and the rest are mostly
+// named in passing. The weights of the tiers are a judgement of how much
+// more prominent each is than the next.
+const (
+	iconicTierWeight  = 20
+	sourcedTierWeight = 5
+	passingTierWeight = 1
+)
+
+// tierWeight returns the weight of the editorial tier of a ship.
+func tierWeight(iconic bool, source string) int {
+	switch {
+	case iconic:
+		return iconicTierWeight
+	case source != "":
+		return sourcedTierWeight
+	default:
+		return passingTierWeight
+	}
+}
+
+// GenerateByTier is like GenerateMatching, but draws each ship in proportion
+// to the weight of its editorial tier, which the returned Ship holds in
+// TierWeight.
+func GenerateByTier(separator string, rnd *rand.Rand, filters ...Filter) (Ship, error) {
+	return ships().generateByTier(separator, rnd, filters)
+}
+
+func (c *catalogue) generateByTier(separator string, rnd *rand.Rand, filters []Filter) (Ship, error) {
+	candidates := c.matching(filters)
+	if len(candidates) == 0 {
+		return Ship{}, ErrNoMatchingShips
+	}
+
+	return drawWeighted(candidates, rnd, func(s catalogueShip) int {
+		return s.tierWeight
+	}).ship(separator), nil
+}
@@ -0,0 +1,63 @@
+package spaceships
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestTierWeight(t *testing.T) {
+	for name, want := range map[string]int{
+		"Sleeper Service": iconicTierWeight,
+		"Killing Time":    sourcedTierWeight,
+		"Xenophobe":       passingTierWeight,
+	} {
+		ship, ok := Find(name, " ")
+		if !ok {
+			t.Fatalf("expected to find %q", name)
+		}
+
+		if ship.TierWeight != want {
+			t.Errorf("expected %q to have a tier weight of %d, got %d", name, want, ship.TierWeight)
+		}
+	}
+}
+
+// TestGenerateByTier draws many ships and checks that each tier of ship
+// comes up about as often as the weights of its ships say.
+func TestGenerateByTier(t *testing.T) {
+	const draws = 100000
+
+	rnd := rand.New(rand.NewSource(1))
+
+	got := make(map[int]int)
+	for i := 0; i < draws; i++ {
+		ship, err := GenerateByTier(" ", rnd)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		got[ship.TierWeight]++
+	}
+
+	total := 0
+	weights := make(map[int]int)
+	for _, s := range ships().ships {
+		total += s.tierWeight
+		weights[s.tierWeight] += s.tierWeight
+	}
+
+	for _, tier := range []int{iconicTierWeight, sourcedTierWeight, passingTierWeight} {
+		want := float64(weights[tier]) / float64(total)
+		share := float64(got[tier]) / draws
+
+		if share < want*0.9 || share > want*1.1 {
+			t.Errorf("expected ships weighing %d to be drawn %.3f of the time, got %.3f", tier, want, share)
+		}
+	}
+}
+
+func TestGenerateByTier_NoMatchingShips(t *testing.T) {
+	if _, err := GenerateByTier(" ", nil, IncludingOnly(nil)); !errors.Is(err, ErrNoMatchingShips) {
+		t.Errorf("expected ErrNoMatchingShips, got %v", err)
+	}
+}
@@ -0,0 +1,115 @@
+package spaceships
+
+import (
+	"math/rand"
+	"sort"
+)
+
+// DefaultIconicWeight is how many times more likely GenerateWeighted is to
+// draw each iconic ship than any other.
+const DefaultIconicWeight = 3
+
+// iconicShips are the best known ships of the series, favoured by
+// GenerateWeighted.
+var iconicShips = [...]string{
+	"Falling Outside the Normal Moral Constraints",
+	"Grey Area",
+	"Just Read The Instructions",
+	"Mistake Not...",
+	"Of Course I Still Love You",
+	"Sleeper Service",
+	"So Much For Subtlety",
+}
+
+// Iconic returns the names of the iconic ships favoured by GenerateWeighted,
+// as written in the books, sorted.
+func Iconic() []string {
+	return append([]string(nil), iconicShips[:]...)
+}
+
+// GenerateWeighted is like Generate, but each iconic ship is
+// DefaultIconicWeight times more likely to be drawn than any other.
+func GenerateWeighted(separator string) string {
+	ship, _ := GenerateMatchingWeighted(separator, nil, DefaultIconicWeight)
+	return ship.Name
+}
+
+// GenerateMatchingWeighted is like GenerateMatching, but each iconic ship is
+// iconicWeight times more likely to be drawn than any other. A weight of 1
+// draws uniformly, like GenerateMatching.
+func GenerateMatchingWeighted(separator string, rnd *rand.Rand, iconicWeight int, filters ...Filter) (Ship, error) {
+	return ships().generateMatchingWeighted(separator, rnd, iconicWeight, filters)
+}
+
+func (c *catalogue) generateMatchingWeighted(separator string, rnd *rand.Rand, iconicWeight int, filters []Filter) (Ship, error) {
+	if iconicWeight < 1 {
+		iconicWeight = 1
+	}
+
+	return c.subset(filters).drawIconicWeighted(separator, rnd, iconicWeight)
+}
+
+// drawIconicWeighted draws a ship of the whole catalogue as
+// generateMatchingWeighted does, reusing the cumulative weights of the
+// previous draw when it had the same iconicWeight.
+func (c *catalogue) drawIconicWeighted(separator string, rnd *rand.Rand, iconicWeight int) (Ship, error) {
+	if len(c.ships) == 0 {
+		return Ship{}, ErrNoMatchingShips
+	}
+
+	c.weights.Lock()
+	if c.weights.cumulative == nil || c.weights.iconicWeight != iconicWeight {
+		c.weights.iconicWeight = iconicWeight
+		c.weights.cumulative = cumulativeWeights(c.ships, func(s catalogueShip) int {
+			return s.weight(iconicWeight)
+		})
+	}
+	cumulative := c.weights.cumulative
+	c.weights.Unlock()
+
+	return drawCumulative(c.ships, cumulative, rnd).ship(separator), nil
+}
+
+// drawWeighted draws one of the candidates, which must not be empty, from
+// rnd, or from the package's own source if rnd is nil, each in proportion to
+// its weight. Every weight must be at least 1.
+func drawWeighted(candidates []catalogueShip, rnd *rand.Rand, weight func(catalogueShip) int) catalogueShip {
+	return drawCumulative(candidates, cumulativeWeights(candidates, weight), rnd)
+}
+
+// cumulativeWeights returns the running totals of the weights of the
+// candidates, in order.
+func cumulativeWeights(candidates []catalogueShip, weight func(catalogueShip) int) []int {
+	cumulative := make([]int, len(candidates))
+	total := 0
+	for i, s := range candidates {
+		total += weight(s)
+		cumulative[i] = total
+	}
+	return cumulative
+}
+
+// drawCumulative draws one of the candidates, which must not be empty, as
+// drawWeighted does, given their cumulative weights.
+func drawCumulative(candidates []catalogueShip, cumulative []int, rnd *rand.Rand) catalogueShip {
+	total := cumulative[len(cumulative)-1]
+
+	var n int
+	if rnd != nil {
+		n = rnd.Intn(total)
+	} else {
+		n = intn(total)
+	}
+
+	// The candidate drawn is the first whose running total exceeds n
+	return candidates[sort.SearchInts(cumulative, n+1)]
+}
+
+// weight returns the relative likelihood of the ship being drawn by
+// GenerateMatchingWeighted.
+func (s catalogueShip) weight(iconicWeight int) int {
+	if s.iconic {
+		return iconicWeight
+	}
+	return 1
+}
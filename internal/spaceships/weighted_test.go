@@ -0,0 +1,73 @@
+package spaceships
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestIconic(t *testing.T) {
+	for _, cultureShip := range Iconic() {
+		if _, ok := ships().canonical[cultureShip]; !ok {
+			t.Errorf("expected iconic ship %q to be catalogued", cultureShip)
+		}
+	}
+}
+
+// TestGenerateMatchingWeighted draws many ships and checks that the iconic
+// ones come up about as much more often as their weight says.
+func TestGenerateMatchingWeighted(t *testing.T) {
+	const draws = 100000
+
+	rnd := rand.New(rand.NewSource(1))
+	iconic := IncludingOnly(Iconic())
+
+	count := func(weight int) float64 {
+		n := 0
+		for i := 0; i < draws; i++ {
+			ship, err := GenerateMatchingWeighted(" ", rnd, weight)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if iconic.Accepts(ship.Name) {
+				n++
+			}
+		}
+		return float64(n) / draws
+	}
+
+	uniform, weighted := count(1), count(DefaultIconicWeight)
+
+	total := len(ships().ships)
+	var iconicCount int
+	for _, s := range ships().ships {
+		if s.iconic {
+			iconicCount++
+		}
+	}
+
+	wantUniform := float64(iconicCount) / float64(total)
+	wantWeighted := float64(iconicCount*DefaultIconicWeight) / float64(total+iconicCount*(DefaultIconicWeight-1))
+
+	for _, tt := range []struct {
+		name      string
+		got, want float64
+	}{
+		{"uniform", uniform, wantUniform},
+		{"weighted", weighted, wantWeighted},
+	} {
+		if tt.got < tt.want*0.9 || tt.got > tt.want*1.1 {
+			t.Errorf("expected %s draws to be iconic %.3f of the time, got %.3f", tt.name, tt.want, tt.got)
+		}
+	}
+
+	if weighted <= uniform {
+		t.Errorf("expected iconic ships to be drawn more often when weighted, got %.3f, uniformly %.3f", weighted, uniform)
+	}
+}
+
+func TestGenerateMatchingWeighted_NoMatchingShips(t *testing.T) {
+	if _, err := GenerateMatchingWeighted(" ", nil, DefaultIconicWeight, IncludingOnly(nil)); !errors.Is(err, ErrNoMatchingShips) {
+		t.Errorf("expected ErrNoMatchingShips, got %v", err)
+	}
+}
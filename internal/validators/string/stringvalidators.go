@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = isRegexpValidator{}
+
+type isRegexpValidator struct{}
+
+func (v isRegexpValidator) Description(_ context.Context) string {
+	return "value must be a valid regular expression"
+}
+
+func (v isRegexpValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v isRegexpValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Regular Expression",
+			"Attribute "+req.Path.String()+" "+v.Description(ctx)+", got: "+err.Error(),
+		)
+	}
+}
+
+// IsRegexp returns a validator which ensures that the configured string
+// compiles as a regular expression, using the RE2 syntax accepted by the Go
+// regexp package. Null and unknown values are not validated.
+func IsRegexp() validator.String {
+	return isRegexpValidator{}
+}
+
+var _ validator.String = noLineBreaksOrTabsValidator{}
+
+type noLineBreaksOrTabsValidator struct{}
+
+func (v noLineBreaksOrTabsValidator) Description(_ context.Context) string {
+	return "value must not contain tabs, newlines or carriage returns"
+}
+
+func (v noLineBreaksOrTabsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v noLineBreaksOrTabsValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if strings.ContainsAny(value, "\t\n\r") {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Separator",
+			fmt.Sprintf("Attribute %s %s, got: %q. In HCL, \"\\t\", \"\\n\" and \"\\r\" are escape sequences "+
+				"for a tab, a newline and a carriage return rather than literal text, and joining words with "+
+				"them produces names that span several lines or are not valid identifiers elsewhere. Use a "+
+				"printable separator such as \"-\" or \"_\" instead.", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+// NoLineBreaksOrTabs returns a validator which ensures that the configured
+// string contains no tab, newline or carriage return, explaining that HCL
+// reads "\t", "\n" and "\r" as escape sequences. Null and unknown values are
+// not validated.
+func NoLineBreaksOrTabs() validator.String {
+	return noLineBreaksOrTabsValidator{}
+}